@@ -1,18 +1,39 @@
 package main
 
 import (
+	"github.com/cosmos/cosmos-sdk/simapp"
 	desmosapp "github.com/desmos-labs/desmos/app"
 	"github.com/desmos-labs/juno/cmd"
 	initcmd "github.com/desmos-labs/juno/cmd/init"
 	parsecmd "github.com/desmos-labs/juno/cmd/parse"
 
+	"github.com/forbole/bdjuno/cmd/export"
+	"github.com/forbole/bdjuno/cmd/parse"
+	"github.com/forbole/bdjuno/types/chains"
 	"github.com/forbole/bdjuno/types/config"
+	"github.com/forbole/bdjuno/types/encoding"
 
 	"github.com/forbole/bdjuno/database"
 	"github.com/forbole/bdjuno/modules"
+	"github.com/forbole/bdjuno/modules/utils"
 )
 
 func main() {
+	// Setup the supported chain profiles, the first one is used when no chain_profile is configured.
+	// When a chain goes through an upgrade that changes its types, register inside its encoding registry the builder
+	// of the encoding config to be used after it, and set the upgrade height inside the encoding section of the config
+	chainProfiles := chains.NewRegistry(
+		chains.NewProfile(
+			chains.DesmosProfileName,
+			encoding.NewRegistry(desmosapp.MakeTestEncodingConfig),
+			utils.DesmosMessageAddressesParser,
+		),
+		chains.NewProfile(
+			"cosmos",
+			encoding.NewRegistry(simapp.MakeTestEncodingConfig),
+		),
+	)
+
 	// Setup the config
 	initCfg := initcmd.NewConfig().
 		WithConfigFlagSetup(config.SetupConfigFlags).
@@ -20,16 +41,24 @@ func main() {
 
 	parseCfg := parsecmd.NewConfig().
 		WithConfigParser(config.ParseConfig).
-		WithRegistrar(modules.NewRegistrar()).
-		WithDBBuilder(database.Builder).
-		WithEncodingConfigBuilder(desmosapp.MakeTestEncodingConfig)
+		WithRegistrar(modules.NewRegistrar(chainProfiles)).
+		WithDBBuilder(database.Builder)
 
 	cfg := cmd.NewConfig("bdjuno").
 		WithInitConfig(initCfg).
 		WithParseConfig(parseCfg)
 
+	// Build the root command
+	rootCmd := cmd.RootCmd(cfg.GetName())
+	rootCmd.AddCommand(
+		cmd.VersionCmd(),
+		initcmd.InitCmd(cfg.GetInitConfig()),
+		parse.ParseCmd(cfg.GetParseConfig(), chainProfiles),
+		export.ExportCmd(parseCfg, chainProfiles),
+	)
+
 	// Run the command
-	executor := cmd.BuildDefaultExecutor(cfg)
+	executor := cmd.PrepareRootCmd(cfg.GetName(), rootCmd)
 	err := executor.Execute()
 	if err != nil {
 		panic(err)
@@ -0,0 +1,192 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	parsecmd "github.com/desmos-labs/juno/cmd/parse"
+	"github.com/desmos-labs/juno/types"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/database"
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types/chains"
+)
+
+const (
+	flagAddress = "address"
+	flagFrom    = "from"
+	flagTo      = "to"
+	flagFormat  = "format"
+
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+
+	dateLayout = "2006-01-02"
+)
+
+// AccountStatementCmd returns the command that allows to export the statement of a single account.
+// The statement is built using only the data stored inside the database, so no node is required
+func AccountStatementCmd(parseConfig *parsecmd.Config, chainProfiles *chains.Registry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-statement",
+		Short:   "Export the statement of an account, containing all its balance changes between two dates",
+		Example: "bdjuno export account-statement --address cosmos1... --from 2021-01-01 --to 2021-12-31 --format csv",
+		PreRunE: parsecmd.ReadConfig(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, _ := cmd.Flags().GetString(flagAddress)
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if format != FormatCSV && format != FormatJSON {
+				return fmt.Errorf("invalid format %s, must be either %s or %s", format, FormatCSV, FormatJSON)
+			}
+
+			from, to, err := getDatesRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			encodingConfig, err := chainProfiles.BuildLatestEncodingConfig(types.Cfg)
+			if err != nil {
+				return err
+			}
+
+			db, err := parseConfig.GetDBBuilder()(types.Cfg, &encodingConfig)
+			if err != nil {
+				return err
+			}
+
+			rows, err := database.Cast(db).GetAccountStatement(address, from, to)
+			if err != nil {
+				return err
+			}
+
+			entries := make([]StatementEntry, len(rows))
+			for index, row := range rows {
+				entries[index] = NewStatementEntry(row)
+			}
+
+			if format == FormatJSON {
+				return WriteJSON(cmd.OutOrStdout(), entries)
+			}
+			return WriteCSV(cmd.OutOrStdout(), entries)
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "Address of the account whose statement should be exported")
+	cmd.Flags().String(flagFrom, "", "Date (YYYY-MM-DD, UTC) from which to start the statement, included")
+	cmd.Flags().String(flagTo, "", "Date (YYYY-MM-DD, UTC) at which to end the statement, included")
+	cmd.Flags().String(flagFormat, FormatCSV, "Format of the statement, either csv or json")
+
+	_ = cmd.MarkFlagRequired(flagAddress)
+	_ = cmd.MarkFlagRequired(flagFrom)
+	_ = cmd.MarkFlagRequired(flagTo)
+
+	return cmd
+}
+
+// getDatesRange returns the range of times that should be considered based on the command flags.
+// The returned to time is the beginning of the day after the one specified, so that it is included
+func getDatesRange(cmd *cobra.Command) (time.Time, time.Time, error) {
+	fromValue, _ := cmd.Flags().GetString(flagFrom)
+	from, err := time.Parse(dateLayout, fromValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid from date: %s", err)
+	}
+
+	toValue, _ := cmd.Flags().GetString(flagTo)
+	to, err := time.Parse(dateLayout, toValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid to date: %s", err)
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("the to date must not be before the from date")
+	}
+
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// StatementEntry represents a single entry of an account statement
+type StatementEntry struct {
+	Date      string   `json:"date"`
+	Height    int64    `json:"height"`
+	TxHash    string   `json:"tx_hash"`
+	Messages  []string `json:"messages"`
+	Memo      string   `json:"memo"`
+	Cause     string   `json:"cause"`
+	Denom     string   `json:"denom"`
+	Amount    string   `json:"amount"`
+	Balance   string   `json:"balance"`
+	Price     string   `json:"price"`
+	FiatValue string   `json:"fiat_value"`
+}
+
+// NewStatementEntry builds a new StatementEntry from the given database row
+func NewStatementEntry(row dbtypes.AccountStatementRow) StatementEntry {
+	messages := []string(row.MessageTypes)
+	if messages == nil {
+		messages = []string{}
+	}
+
+	return StatementEntry{
+		Date:      row.Timestamp.UTC().Format(time.RFC3339),
+		Height:    row.Height,
+		TxHash:    row.TxHash,
+		Messages:  messages,
+		Memo:      row.Memo,
+		Cause:     row.Cause,
+		Denom:     row.Denom,
+		Amount:    row.Amount,
+		Balance:   dbtypes.ToString(row.Balance),
+		Price:     dbtypes.ToString(row.Price),
+		FiatValue: dbtypes.ToString(row.FiatValue),
+	}
+}
+
+// WriteJSON writes the given entries inside the provided writer as a JSON array
+func WriteJSON(writer io.Writer, entries []StatementEntry) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// WriteCSV writes the given entries inside the provided writer using the CSV format
+func WriteCSV(writer io.Writer, entries []StatementEntry) error {
+	csvWriter := csv.NewWriter(writer)
+
+	err := csvWriter.Write([]string{
+		"date", "height", "tx_hash", "messages", "memo", "cause", "denom", "amount", "balance", "price", "fiat_value",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err = csvWriter.Write([]string{
+			entry.Date,
+			strconv.FormatInt(entry.Height, 10),
+			entry.TxHash,
+			strings.Join(entry.Messages, ";"),
+			entry.Memo,
+			entry.Cause,
+			entry.Denom,
+			entry.Amount,
+			entry.Balance,
+			entry.Price,
+			entry.FiatValue,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
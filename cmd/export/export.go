@@ -0,0 +1,22 @@
+package export
+
+import (
+	parsecmd "github.com/desmos-labs/juno/cmd/parse"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/types/chains"
+)
+
+// ExportCmd returns the command that allows to export the data stored inside the database
+func ExportCmd(parseConfig *parsecmd.Config, chainProfiles *chains.Registry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the data stored inside the database",
+	}
+
+	cmd.AddCommand(
+		AccountStatementCmd(parseConfig, chainProfiles),
+	)
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package parse
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/desmos-labs/juno/types"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// setupLogging setups the logging for the entire project
+func setupLogging(_ *cobra.Command, _ []string) error {
+	cfg := types.Cfg.GetLoggingConfig()
+
+	// Init logging level
+	logLvl, err := zerolog.ParseLevel(cfg.GetLogLevel())
+	if err != nil {
+		return err
+	}
+	zerolog.SetGlobalLevel(logLvl)
+
+	// Init logging format
+	switch cfg.GetLogFormat() {
+	case "json":
+		// JSON is the default logging format
+
+	case "text":
+		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	default:
+		return fmt.Errorf("invalid logging format: %s", cfg.GetLogFormat())
+	}
+
+	return nil
+}
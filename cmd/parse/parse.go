@@ -0,0 +1,206 @@
+package parse
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	parsecmd "github.com/desmos-labs/juno/cmd/parse"
+	"github.com/desmos-labs/juno/modules"
+	"github.com/desmos-labs/juno/types"
+	"github.com/desmos-labs/juno/worker"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/bdjuno/types/chains"
+	"github.com/forbole/bdjuno/types/encoding"
+)
+
+var (
+	waitGroup sync.WaitGroup
+)
+
+// ParseCmd returns the command that should be run when we want to start parsing a chain state.
+// Differently from the Juno one, each height is parsed using the encoding config that the configured chain profile
+// associates with it, so that chains that have gone through upgrades changing their types can be parsed
+func ParseCmd(parseConfig *parsecmd.Config, chainProfiles *chains.Registry) *cobra.Command {
+	return &cobra.Command{
+		Use:     "parse",
+		Short:   "Start parsing the blockchain data",
+		PreRunE: types.ConcatCobraCmdFuncs(parsecmd.ReadConfig(parseConfig), setupLogging),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parsers, err := SetupParsing(parseConfig, chainProfiles)
+			if err != nil {
+				return err
+			}
+
+			return StartParsing(parsers)
+		},
+	}
+}
+
+// StartParsing represents the function that should be called when the parse command is executed.
+// The periodic and async operations are run only by the modules of the latest range
+func StartParsing(parsers []*RangeParser) error {
+	// Get the config
+	cfg := types.Cfg.GetParsingConfig()
+	latest := parsers[len(parsers)-1].Data
+
+	// Start periodic operations
+	scheduler := gocron.NewScheduler(time.UTC)
+	for _, module := range latest.Modules {
+		if module, ok := module.(modules.PeriodicOperationsModule); ok {
+			err := module.RegisterPeriodicOperations(scheduler)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	scheduler.StartAsync()
+
+	// Create a queue that will collect all the heights, dispatching them to the parser of their range
+	exportQueue := types.NewQueue(25)
+	go dispatchHeights(exportQueue, parsers)
+
+	// Create the workers of each range
+	var workers []worker.Worker
+	for _, parser := range parsers {
+		config := worker.NewConfig(parser.Queue, parser.Data.EncodingConfig, parser.Data.Proxy,
+			parser.Data.Database, parser.Data.Modules)
+		for i := int64(0); i < cfg.GetWorkers(); i++ {
+			workers = append(workers, worker.NewWorker(config))
+		}
+	}
+
+	waitGroup.Add(1)
+
+	// Run all the async operations
+	for _, module := range latest.Modules {
+		if module, ok := module.(modules.AsyncOperationsModule); ok {
+			go module.RunAsyncOperations()
+		}
+	}
+
+	// Start each blocking worker in a go-routine where the worker consumes jobs
+	// off of the queue of its range
+	for i, w := range workers {
+		log.Debug().Int("number", i+1).Msg("starting worker...")
+
+		go w.Start()
+	}
+
+	// Listen for and trap any OS signal to gracefully shutdown and exit
+	trapSignal(parsers)
+
+	if cfg.ShouldParseGenesis() {
+		// Add the genesis to the queue if requested
+		exportQueue <- 0
+	}
+
+	if cfg.ShouldParseOldBlocks() {
+		go enqueueMissingBlocks(exportQueue, latest)
+	}
+
+	if cfg.ShouldParseNewBlocks() {
+		go startNewBlockListener(exportQueue, latest)
+	}
+
+	// Block main process (signal capture will call WaitGroup's Done)
+	waitGroup.Wait()
+	return nil
+}
+
+// dispatchHeights sends each height of the given queue to the queue of the parser whose range contains it
+func dispatchHeights(exportQueue types.HeightQueue, parsers []*RangeParser) {
+	ranges := make([]encoding.Range, len(parsers))
+	for index, parser := range parsers {
+		ranges[index] = parser.Range
+	}
+
+	for height := range exportQueue {
+		parsers[encoding.GetRangeIndex(ranges, height)].Queue <- height
+	}
+}
+
+// enqueueMissingBlocks enqueues jobs (block heights) for missed blocks starting
+// at the startHeight up until the latest known height.
+func enqueueMissingBlocks(exportQueue types.HeightQueue, data *parsecmd.ParserData) {
+	// Get the config
+	cfg := types.Cfg.GetParsingConfig()
+
+	// Get the latest height
+	latestBlockHeight, err := data.Proxy.LatestHeight()
+	if err != nil {
+		log.Fatal().Err(fmt.Errorf("failed to get last block from RPC client: %s", err))
+	}
+
+	if cfg.UseFastSync() {
+		log.Info().Int64("latest_block_height", latestBlockHeight).
+			Msg("fast sync is enabled, ignoring all previous blocks")
+		for _, module := range data.Modules {
+			if mod, ok := module.(modules.FastSyncModule); ok {
+				err := mod.DownloadState(latestBlockHeight)
+				if err != nil {
+					log.Error().Err(err).
+						Int64("last_block_height", latestBlockHeight).
+						Str("module", module.Name()).
+						Msg("error while performing fast sync")
+				}
+			}
+		}
+	} else {
+		log.Info().Int64("latest_block_height", latestBlockHeight).
+			Msg("syncing missing blocks...")
+		for i := cfg.GetStartHeight(); i <= latestBlockHeight; i++ {
+			log.Debug().Int64("height", i).Msg("enqueueing missing block")
+			exportQueue <- i
+		}
+	}
+}
+
+// startNewBlockListener subscribes to new block events via the Tendermint RPC
+// and enqueues each new block height onto the provided queue. It blocks as new
+// blocks are incoming.
+func startNewBlockListener(exportQueue types.HeightQueue, data *parsecmd.ParserData) {
+	eventCh, cancel, err := data.Proxy.SubscribeNewBlocks(types.Cfg.GetRPCConfig().GetClientName() + "-blocks")
+	defer cancel()
+
+	if err != nil {
+		log.Fatal().Err(fmt.Errorf("failed to subscribe to new blocks: %s", err))
+	}
+
+	log.Info().Msg("listening for new block events...")
+
+	for e := range eventCh {
+		newBlock := e.Data.(tmtypes.EventDataNewBlock).Block
+		height := newBlock.Header.Height
+
+		log.Debug().Int64("height", height).Msg("enqueueing new block")
+		exportQueue <- height
+	}
+}
+
+// trapSignal will listen for any OS signal and invoke Done on the main
+// WaitGroup allowing the main process to gracefully exit.
+func trapSignal(parsers []*RangeParser) {
+	var sigCh = make(chan os.Signal, 1)
+
+	signal.Notify(sigCh, syscall.SIGTERM)
+	signal.Notify(sigCh, syscall.SIGINT)
+
+	go func() {
+		sig := <-sigCh
+		log.Info().Str("signal", sig.String()).Msg("caught signal; shutting down...")
+		defer waitGroup.Done()
+
+		// All the parsers share the same client and database connections
+		latest := parsers[len(parsers)-1].Data
+		latest.Proxy.Stop()
+		latest.Database.Close()
+	}()
+}
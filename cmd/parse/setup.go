@@ -0,0 +1,105 @@
+package parse
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/juno/client"
+	parsecmd "github.com/desmos-labs/juno/cmd/parse"
+	"github.com/desmos-labs/juno/modules"
+	modsregistrar "github.com/desmos-labs/juno/modules/registrar"
+	"github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types/chains"
+	"github.com/forbole/bdjuno/types/config"
+	"github.com/forbole/bdjuno/types/encoding"
+)
+
+// RangeParser contains the data that should be used to parse the heights of a single encoding range
+type RangeParser struct {
+	Range encoding.Range
+	Data  *parsecmd.ParserData
+	Queue types.HeightQueue
+}
+
+// SetupParsing setups, for each encoding range of the configured chain profile, all the things that should be
+// later passed to StartParsing in order to parse the heights of such range using the proper encoding config.
+// All the ranges share the same database connections pool and client proxy, and only differ by their codec.
+// The additional operations are run only by the modules of the latest range
+func SetupParsing(parseConfig *parsecmd.Config, chainProfiles *chains.Registry) ([]*RangeParser, error) {
+	// Get the global config
+	cfg := types.Cfg
+
+	// Get the chain profile
+	profile, err := chainProfiles.GetConfiguredProfile(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the encoding ranges
+	ranges, err := profile.Encoding.GetRanges(config.Cast(cfg).GetEncodingConfig().GetUpgrades())
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup the SDK configuration
+	sdkConfig := sdk.GetConfig()
+	parseConfig.GetSetupConfig()(cfg, sdkConfig)
+	sdkConfig.Seal()
+
+	// Get the database, using the latest encoding config
+	latestEncodingConfig := ranges[len(ranges)-1].Builder()
+	db, err := parseConfig.GetDBBuilder()(cfg, &latestEncodingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Init the client
+	cp, err := client.NewClientProxy(cfg, &latestEncodingConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start client: %s", err)
+	}
+
+	parsers := make([]*RangeParser, len(ranges))
+	for index, encodingRange := range ranges {
+		data := setupRangeParsing(parseConfig, encodingRange, sdkConfig, database.Cast(db), cp)
+
+		parsers[index] = &RangeParser{
+			Range: encodingRange,
+			Data:  data,
+			Queue: types.NewQueue(25),
+		}
+	}
+
+	// Run all the additional operations
+	for _, module := range parsers[len(parsers)-1].Data.Modules {
+		if module, ok := module.(modules.AdditionalOperationsModule); ok {
+			err := module.RunAdditionalOperations()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return parsers, nil
+}
+
+// setupRangeParsing builds the codec and the modules that should be used to parse the given range,
+// making them use the given database and client
+func setupRangeParsing(
+	parseConfig *parsecmd.Config, encodingRange encoding.Range, sdkConfig *sdk.Config,
+	db *database.Db, cp *client.Proxy,
+) *parsecmd.ParserData {
+	cfg := types.Cfg
+
+	// Build the codec, and make the database use it
+	encodingConfig := encodingRange.Builder()
+	rangeDb := db.WithEncodingConfig(&encodingConfig)
+
+	// Get the modules
+	mods := parseConfig.GetRegistrar().BuildModules(cfg, &encodingConfig, sdkConfig, rangeDb, cp)
+	registeredModules := modsregistrar.GetModules(mods, cfg.GetCosmosConfig().GetModules())
+
+	return parsecmd.NewParserData(&encodingConfig, cp, rangeDb, registeredModules)
+}
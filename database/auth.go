@@ -1,8 +1,17 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
+	"time"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
 	dbutils "github.com/forbole/bdjuno/database/utils"
 
 	"github.com/forbole/bdjuno/types"
@@ -54,3 +63,328 @@ func (db *Db) GetAccounts() ([]string, error) {
 	err := db.Sqlx.Select(&rows, `SELECT address FROM account`)
 	return rows, err
 }
+
+// SaveAccountsDetails stores the given accounts details inside the database,
+// replacing any existing details that refer to a previous height
+func (db *Db) SaveAccountsDetails(details []types.AccountDetails) error {
+	if len(details) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO account (address, type, pubkey, pubkey_type, account_number, sequence, module_name, height) VALUES `
+	var params []interface{}
+
+	for i, account := range details {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d),",
+			ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		pubKey, pubKeyType, err := db.marshalPubKey(account.PubKey)
+		if err != nil {
+			return err
+		}
+
+		params = append(params,
+			account.Address, account.Type, pubKey, pubKeyType, account.AccountNumber, account.Sequence,
+			dbtypes.ToNullString(account.ModuleName), account.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+	SET type = excluded.type,
+	    pubkey = coalesce(excluded.pubkey, account.pubkey),
+	    pubkey_type = coalesce(excluded.pubkey_type, account.pubkey_type),
+	    account_number = excluded.account_number,
+	    sequence = excluded.sequence,
+	    module_name = excluded.module_name,
+	    height = excluded.height
+WHERE account.height IS NULL OR account.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// SaveAccountsSignatures updates the public keys and sequences of the accounts that signed a transaction.
+// If an account is not yet stored, it is created.
+func (db *Db) SaveAccountsSignatures(signatures []types.AccountSignature) error {
+	if len(signatures) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO account (address, type, pubkey, pubkey_type, sequence, height) VALUES `
+	var params []interface{}
+
+	for i, signature := range signatures {
+		si := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", si+1, si+2, si+3, si+4, si+5, si+6)
+
+		pubKey, pubKeyType, err := db.marshalPubKey(signature.PubKey)
+		if err != nil {
+			return err
+		}
+
+		params = append(params,
+			signature.Address, types.GetPubKeyAccountType(signature.PubKey), pubKey, pubKeyType,
+			signature.Sequence, signature.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+	SET type = CASE WHEN account.type IS NULL OR account.type = 'base' THEN excluded.type ELSE account.type END,
+	    pubkey = coalesce(excluded.pubkey, account.pubkey),
+	    pubkey_type = coalesce(excluded.pubkey_type, account.pubkey_type),
+	    sequence = excluded.sequence,
+	    height = excluded.height
+WHERE account.height IS NULL OR account.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// marshalPubKey returns the JSON representation of the given public key along with its type.
+// If the key is nil, two null values are returned instead.
+func (db *Db) marshalPubKey(pubKey cryptotypes.PubKey) (sql.NullString, sql.NullString, error) {
+	if pubKey == nil {
+		return sql.NullString{}, sql.NullString{}, nil
+	}
+
+	bz, err := db.EncodingConfig.Marshaler.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return sql.NullString{}, sql.NullString{}, err
+	}
+
+	return dbtypes.ToNullString(string(bz)), dbtypes.ToNullString("/" + proto.MessageName(pubKey)), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveMultisigAccount stores the given multisig account along with its members.
+// It assumes that the multisig account address is already present inside the account table.
+func (db *Db) SaveMultisigAccount(account types.MultisigAccount) error {
+	stmt := `
+INSERT INTO multisig_account (address, threshold, height) 
+VALUES ($1, $2, $3)
+ON CONFLICT (address) DO UPDATE 
+    SET threshold = excluded.threshold,
+        height = excluded.height
+WHERE multisig_account.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, account.Address, account.Threshold, account.Height)
+	if err != nil {
+		return err
+	}
+
+	var members = make([]types.Account, len(account.Members))
+	for index, member := range account.Members {
+		members[index] = types.NewAccount(member)
+	}
+
+	err = db.SaveAccounts(members)
+	if err != nil {
+		return err
+	}
+
+	stmt = `INSERT INTO multisig_account_member (multisig_address, member_address, member_index) VALUES `
+	var params []interface{}
+
+	for i, member := range account.Members {
+		mi := i * 3
+		stmt += fmt.Sprintf("($%d, $%d, $%d),", mi+1, mi+2, mi+3)
+		params = append(params, account.Address, member, i)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += " ON CONFLICT DO NOTHING"
+	_, err = db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// SaveMultisigSignature stores the members of a multisig account that have signed a transaction.
+// It assumes that the multisig account and its members are already stored inside the database.
+func (db *Db) SaveMultisigSignature(signature types.MultisigSignature) error {
+	if len(signature.Signers) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO multisig_signature (transaction_hash, multisig_address, member_address, height) VALUES `
+	var params []interface{}
+
+	for i, signer := range signature.Signers {
+		si := i * 4
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d),", si+1, si+2, si+3, si+4)
+		params = append(params, signature.TxHash, signature.MultisigAddress, signer, signature.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += " ON CONFLICT DO NOTHING"
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveVestingAccounts saves the given vesting accounts inside the database.
+// It assumes that the addresses of the accounts are already present inside the account table.
+func (db *Db) SaveVestingAccounts(accounts []exported.VestingAccount) error {
+	for _, account := range accounts {
+		err := db.saveVestingAccount(account)
+		if err != nil {
+			return fmt.Errorf("error while storing vesting account %s: %s", account.GetAddress(), err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveVestingAccount(account exported.VestingAccount) error {
+	stmt := `
+INSERT INTO vesting_account (type, address, original_vesting, start_time, end_time) 
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (address) DO UPDATE 
+    SET type = excluded.type,
+        original_vesting = excluded.original_vesting, 
+        start_time = excluded.start_time, 
+        end_time = excluded.end_time
+RETURNING id`
+
+	var startTime sql.NullTime
+	if account.GetStartTime() != 0 {
+		startTime = sql.NullTime{Time: time.Unix(account.GetStartTime(), 0).UTC(), Valid: true}
+	}
+
+	var id int64
+	err := db.Sql.QueryRow(stmt,
+		"/"+proto.MessageName(account),
+		account.GetAddress().String(),
+		pq.Array(dbtypes.NewDbCoins(account.GetOriginalVesting())),
+		startTime,
+		time.Unix(account.GetEndTime(), 0).UTC(),
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	periodicAccount, ok := account.(*vestingtypes.PeriodicVestingAccount)
+	if !ok {
+		return nil
+	}
+
+	return db.saveVestingPeriods(id, periodicAccount.VestingPeriods)
+}
+
+// saveVestingPeriods replaces the periods of the vesting account having the given id with the given ones
+func (db *Db) saveVestingPeriods(id int64, periods vestingtypes.Periods) error {
+	_, err := db.Sql.Exec(`DELETE FROM vesting_period WHERE vesting_account_id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if len(periods) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO vesting_period (vesting_account_id, period_order, length, amount) VALUES `
+	var params []interface{}
+
+	for i, period := range periods {
+		pi := i * 4
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d),", pi+1, pi+2, pi+3, pi+4)
+		params = append(params, id, i, period.Length, pq.Array(dbtypes.NewDbCoins(period.Amount)))
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	_, err = db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// GetVestingAccountsAddresses returns the addresses of all the vesting accounts stored inside the database
+func (db *Db) GetVestingAccountsAddresses() ([]string, error) {
+	var rows []string
+	err := db.Sqlx.Select(&rows, `SELECT address FROM vesting_account`)
+	return rows, err
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveVestingBalances allows to store the given vesting balances inside the database
+func (db *Db) SaveVestingBalances(balances []types.VestingBalance) error {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	err := db.saveUpToDateVestingBalances(balances)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date vesting balances: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.saveHistoricVestingBalances(balances)
+		if err != nil {
+			return fmt.Errorf("error while storing vesting balances history: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveUpToDateVestingBalances(balances []types.VestingBalance) error {
+	stmt := `INSERT INTO vesting_balance (address, vested, unvested, delegated_vesting, delegated_free, height) VALUES `
+	var params []interface{}
+
+	for i, bal := range balances {
+		bi := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", bi+1, bi+2, bi+3, bi+4, bi+5, bi+6)
+		params = append(params,
+			bal.Address,
+			pq.Array(dbtypes.NewDbCoins(bal.Vested)),
+			pq.Array(dbtypes.NewDbCoins(bal.Unvested)),
+			pq.Array(dbtypes.NewDbCoins(bal.DelegatedVesting)),
+			pq.Array(dbtypes.NewDbCoins(bal.DelegatedFree)),
+			bal.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+	SET vested = excluded.vested, 
+	    unvested = excluded.unvested,
+	    delegated_vesting = excluded.delegated_vesting,
+	    delegated_free = excluded.delegated_free,
+	    height = excluded.height 
+WHERE vesting_balance.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
+
+func (db *Db) saveHistoricVestingBalances(balances []types.VestingBalance) error {
+	stmt := `INSERT INTO vesting_balance_history (address, vested, unvested, delegated_vesting, delegated_free, height) VALUES `
+	var params []interface{}
+
+	for i, bal := range balances {
+		bi := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", bi+1, bi+2, bi+3, bi+4, bi+5, bi+6)
+		params = append(params,
+			bal.Address,
+			pq.Array(dbtypes.NewDbCoins(bal.Vested)),
+			pq.Array(dbtypes.NewDbCoins(bal.Unvested)),
+			pq.Array(dbtypes.NewDbCoins(bal.DelegatedVesting)),
+			pq.Array(dbtypes.NewDbCoins(bal.DelegatedFree)),
+			bal.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_vesting_balance_for_height DO UPDATE 
+	SET vested = excluded.vested, 
+	    unvested = excluded.unvested,
+	    delegated_vesting = excluded.delegated_vesting,
+	    delegated_free = excluded.delegated_free`
+
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
@@ -1,8 +1,13 @@
 package database_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/forbole/bdjuno/types"
 
@@ -71,3 +76,163 @@ func (suite *DbTestSuite) TestBigDipperDb_GetAccounts() {
 		suite.Require().Equal(acc, accounts[index])
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveVestingAccounts() {
+	address, err := sdk.AccAddressFromBech32("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7")
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccounts([]types.Account{types.NewAccount(address.String())})
+	suite.Require().NoError(err)
+
+	original := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	account := vestingtypes.NewPeriodicVestingAccount(
+		authttypes.NewBaseAccountWithAddress(address),
+		original,
+		1000,
+		vestingtypes.Periods{
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(40)))},
+			{Length: 200, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(60)))},
+		},
+	)
+
+	err = suite.database.SaveVestingAccounts([]exported.VestingAccount{account})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveVestingAccounts([]exported.VestingAccount{account})
+	suite.Require().NoError(err, "double vesting account insertion should update the existing one")
+
+	// Verify the data
+	addresses, err := suite.database.GetVestingAccountsAddresses()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{address.String()}, addresses)
+
+	var accountType string
+	err = suite.database.Sqlx.Get(&accountType, `SELECT type FROM vesting_account`)
+	suite.Require().NoError(err)
+	suite.Require().Equal("/cosmos.vesting.v1beta1.PeriodicVestingAccount", accountType)
+
+	var periodsCount int
+	err = suite.database.Sqlx.Get(&periodsCount, `SELECT count(*) FROM vesting_period`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, periodsCount)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsDetails() {
+	moduleAccount := authttypes.NewEmptyModuleAccount("distribution")
+	moduleAccount.AccountNumber = 5
+
+	err := suite.database.SaveAccountsDetails([]types.AccountDetails{
+		types.NewAccountDetails(moduleAccount, 10),
+	})
+	suite.Require().NoError(err)
+
+	// Try updating with a lower height
+	moduleAccount.Sequence = 2
+	err = suite.database.SaveAccountsDetails([]types.AccountDetails{
+		types.NewAccountDetails(moduleAccount, 9),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AccountRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(moduleAccount.GetAddress().String(), rows[0].Address)
+	suite.Require().Equal(types.AccountTypeModule, rows[0].Type.String)
+	suite.Require().Equal("distribution", rows[0].ModuleName.String)
+	suite.Require().Equal(int64(5), rows[0].AccountNumber.Int64)
+	suite.Require().Equal(int64(0), rows[0].Sequence.Int64)
+	suite.Require().False(rows[0].PubKey.Valid)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsSignatures() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	address := sdk.AccAddress(pubKey.Address()).String()
+
+	err := suite.database.SaveAccountsSignatures([]types.AccountSignature{
+		types.NewAccountSignature(address, pubKey, 1, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountsSignatures([]types.AccountSignature{
+		types.NewAccountSignature(address, pubKey, 2, 11),
+	})
+	suite.Require().NoError(err)
+
+	// Signatures without a public key must update the sequence and keep the stored key
+	err = suite.database.SaveAccountsSignatures([]types.AccountSignature{
+		types.NewAccountSignature(address, nil, 3, 12),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AccountRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(address, rows[0].Address)
+	suite.Require().Equal(types.AccountTypeBase, rows[0].Type.String)
+	suite.Require().Equal("/cosmos.crypto.secp256k1.PubKey", rows[0].PubKeyType.String)
+	suite.Require().Equal(int64(3), rows[0].Sequence.Int64)
+	suite.Require().Equal(int64(12), rows[0].Height.Int64)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveMultisigAccount() {
+	suite.getBlock(10)
+
+	memberKeys := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+	multisigPubKey := multisig.NewLegacyAminoPubKey(2, memberKeys)
+	account := types.NewMultisigAccount(multisigPubKey, 10)
+
+	err := suite.database.SaveAccountsSignatures([]types.AccountSignature{
+		types.NewAccountSignature(account.Address, multisigPubKey, 1, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMultisigAccount(account)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMultisigAccount(account)
+	suite.Require().NoError(err, "double multisig account insertion should return no error")
+
+	_, err = suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('hash', 10, true, '{}')`)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMultisigSignature(types.NewMultisigSignature(
+		"hash",
+		account.Address,
+		[]string{account.Members[0], account.Members[2]},
+		10,
+	))
+	suite.Require().NoError(err)
+
+	// Verify the data
+	var threshold int
+	err = suite.database.Sql.QueryRow(
+		`SELECT threshold FROM multisig_account WHERE address = $1`, account.Address).Scan(&threshold)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, threshold)
+
+	var accountType string
+	err = suite.database.Sql.QueryRow(`SELECT type FROM account WHERE address = $1`, account.Address).Scan(&accountType)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AccountTypeMultisig, accountType)
+
+	var members []string
+	err = suite.database.Sqlx.Select(&members,
+		`SELECT member_address FROM multisig_account_member ORDER BY member_index`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(account.Members, members)
+
+	var signers []string
+	err = suite.database.Sqlx.Select(&signers,
+		`SELECT member_address FROM multisig_signature WHERE transaction_hash = 'hash' ORDER BY member_address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(signers, 2)
+	suite.Require().Contains(signers, account.Members[0])
+	suite.Require().Contains(signers, account.Members[2])
+}
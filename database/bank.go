@@ -2,6 +2,7 @@ package database
 
 import (
 	"fmt"
+	"time"
 
 	dbtypes "github.com/forbole/bdjuno/database/types"
 
@@ -91,8 +92,117 @@ func (db *Db) saveHistoricBalances(paramsNumber int, balances []types.AccountBal
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// SaveBalanceChanges allows to store the given balance changes inside the database
+func (db *Db) SaveBalanceChanges(changes []types.BalanceChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Make sure all the accounts exist
+	var accounts = make([]types.Account, len(changes))
+	for index, change := range changes {
+		accounts[index] = types.NewAccount(change.Address)
+	}
+
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return err
+	}
+
+	stmt := `INSERT INTO balance_change (address, denom, amount, cause, height, transaction_hash) VALUES `
+	var params []interface{}
+
+	for i, change := range changes {
+		ci := i * 6
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", ci+1, ci+2, ci+3, ci+4, ci+5, ci+6)
+		params = append(params,
+			change.Address, change.Denom, change.Amount.String(), change.Cause, change.Height,
+			dbtypes.ToNullString(change.TxHash),
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address, denom, height, COALESCE(transaction_hash, ''), cause) DO UPDATE 
+	SET amount = excluded.amount`
+
+	_, err = db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// GetAccountStatement returns the statement of the account having the given address, containing all the
+// balance changes that happened between the given from (included) and to (excluded) times.
+// Each entry contains the balance of the account after the change and the fiat value of the change, if known
+func (db *Db) GetAccountStatement(address string, from, to time.Time) ([]dbtypes.AccountStatementRow, error) {
+	stmt := `
+SELECT block.timestamp,
+       balance_change.height,
+       COALESCE(balance_change.transaction_hash, '') AS transaction_hash,
+       COALESCE(messages.types, '{}')                AS message_types,
+       COALESCE(transaction.memo, '')                AS memo,
+       balance_change.cause,
+       balance_change.denom,
+       balance_change.amount,
+       balance.amount                                AS balance,
+       price.price                                   AS price,
+       balance_change.amount * price.price           AS fiat_value
+FROM balance_change
+         JOIN block ON block.height = balance_change.height
+         LEFT JOIN transaction ON transaction.hash = balance_change.transaction_hash
+         LEFT JOIN LATERAL (
+    SELECT array_agg(message.type ORDER BY message.index) AS types
+    FROM message
+    WHERE message.transaction_hash = balance_change.transaction_hash
+    ) AS messages ON TRUE
+         LEFT JOIN LATERAL (
+    SELECT COALESCE((SELECT coin.amount FROM unnest(history.coins) AS coin WHERE coin.denom = balance_change.denom),
+                    '0') AS amount
+    FROM account_balance_history AS history
+    WHERE history.address = balance_change.address
+      AND history.height <= balance_change.height
+    ORDER BY history.height DESC
+    LIMIT 1
+    ) AS balance ON TRUE
+         LEFT JOIN LATERAL (
+    SELECT token_price_history.price / power(10::NUMERIC, unit.exponent - base_unit.exponent) AS price
+    FROM token_unit AS base_unit
+             JOIN token_unit AS unit ON unit.token_name = base_unit.token_name
+             JOIN token_price_history ON token_price_history.unit_name = unit.denom
+    WHERE (base_unit.denom = balance_change.denom OR balance_change.denom = ANY (base_unit.aliases))
+      AND token_price_history.timestamp <= block.timestamp
+    ORDER BY token_price_history.timestamp DESC
+    LIMIT 1
+    ) AS price ON TRUE
+WHERE balance_change.address = $1
+  AND block.timestamp >= $2
+  AND block.timestamp < $3
+ORDER BY balance_change.height, transaction_hash, balance_change.cause, balance_change.denom`
+
+	var rows []dbtypes.AccountStatementRow
+	err := db.Sqlx.Select(&rows, stmt, address, from, to)
+	return rows, err
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 // SaveSupply allows to save for the given height the given total amount of coins
 func (db *Db) SaveSupply(coins sdk.Coins, height int64) error {
+	err := db.saveUpToDateSupply(coins, height)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date supply: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.saveHistoricSupply(coins, height)
+		if err != nil {
+			return fmt.Errorf("error while storing supply history: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveUpToDateSupply(coins sdk.Coins, height int64) error {
 	query := `
 INSERT INTO supply (coins, height) 
 VALUES ($1, $2) 
@@ -102,12 +212,93 @@ ON CONFLICT (one_row_id) DO UPDATE
 WHERE supply.height <= excluded.height`
 
 	_, err := db.Sql.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	return err
+}
+
+func (db *Db) saveHistoricSupply(coins sdk.Coins, height int64) error {
+	query := `
+INSERT INTO supply_history (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT ON CONSTRAINT unique_supply_for_height DO UPDATE 
+    SET coins = excluded.coins`
+
+	_, err := db.Sql.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	return err
+}
+
+// GetSupply returns the most up-to-date total supply, or nil if it has not been stored yet
+func (db *Db) GetSupply() (sdk.Coins, error) {
+	var rows []dbtypes.SupplyRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM supply`)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if len(rows) == 0 || rows[0].Coins == nil {
+		return nil, nil
+	}
+
+	return rows[0].Coins.ToCoins(), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveCirculatingSupply allows to save for the given height the given circulating amount of coins
+func (db *Db) SaveCirculatingSupply(coins sdk.Coins, height int64) error {
+	err := db.saveUpToDateCirculatingSupply(coins, height)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date circulating supply: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.saveHistoricCirculatingSupply(coins, height)
+		if err != nil {
+			return fmt.Errorf("error while storing circulating supply history: %s", err)
+		}
 	}
+
 	return nil
 }
 
+func (db *Db) saveUpToDateCirculatingSupply(coins sdk.Coins, height int64) error {
+	query := `
+INSERT INTO circulating_supply (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET coins = excluded.coins,
+    	height = excluded.height
+WHERE circulating_supply.height <= excluded.height`
+
+	_, err := db.Sql.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	return err
+}
+
+func (db *Db) saveHistoricCirculatingSupply(coins sdk.Coins, height int64) error {
+	query := `
+INSERT INTO circulating_supply_history (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT ON CONSTRAINT unique_circulating_supply_for_height DO UPDATE 
+    SET coins = excluded.coins`
+
+	_, err := db.Sql.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	return err
+}
+
+// GetCirculatingSupply returns the most up-to-date circulating supply, or nil if it has not been computed yet
+func (db *Db) GetCirculatingSupply() (sdk.Coins, error) {
+	var rows []dbtypes.SupplyRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM circulating_supply`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 || rows[0].Coins == nil {
+		return nil, nil
+	}
+
+	return rows[0].Coins.ToCoins(), nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetTokenNames returns the list of token names stored inside the supply table
@@ -1,6 +1,8 @@
 package database_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lib/pq"
 
@@ -117,7 +119,110 @@ func (suite *DbTestSuite) TestSaveAccountBalance() {
 	}
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveBalanceChanges() {
+	suite.getBlock(10)
+
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('hash', 10, true, '{}')`)
+	suite.Require().NoError(err)
+
+	changes := []types.BalanceChange{
+		types.NewBalanceChange("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7", "uatom", sdk.NewInt(-100),
+			types.BalanceChangeCauseTransfer, 10, "hash"),
+		types.NewBalanceChange("cosmos1tcpsdy9alvucwj0h23n56tey6zmrvkm7sndh9j", "uatom", sdk.NewInt(100),
+			types.BalanceChangeCauseTransfer, 10, "hash"),
+		types.NewBalanceChange("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7", "uatom", sdk.NewInt(50),
+			types.BalanceChangeCauseUnbonding, 10, ""),
+	}
+
+	err = suite.database.SaveBalanceChanges(changes)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBalanceChanges(changes)
+	suite.Require().NoError(err, "double balance changes insertion should return no error")
+
+	var rows []struct {
+		Address string `db:"address"`
+		Amount  int64  `db:"amount"`
+		Cause   string `db:"cause"`
+	}
+	err = suite.database.Sqlx.Select(&rows,
+		`SELECT address, amount, cause FROM balance_change ORDER BY address, amount`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+	suite.Require().Equal(int64(-100), rows[0].Amount)
+	suite.Require().Equal(types.BalanceChangeCauseTransfer, rows[0].Cause)
+	suite.Require().Equal(int64(50), rows[1].Amount)
+	suite.Require().Equal(types.BalanceChangeCauseUnbonding, rows[1].Cause)
+	suite.Require().Equal("cosmos1tcpsdy9alvucwj0h23n56tey6zmrvkm7sndh9j", rows[2].Address)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetAccountStatement() {
+	block := suite.getBlock(10)
+	address := suite.getAccount("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7")
+
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures, memo) VALUES ('hash', 10, true, '{}', 'memo')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.Sql.Exec(
+		`INSERT INTO message (transaction_hash, index, type, value) VALUES ('hash', 0, 'cosmos.bank.v1beta1.MsgSend', '{}')`)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountBalances([]types.AccountBalance{
+		types.NewAccountBalance(address.String(), sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(900))), 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveToken(types.NewToken("daric", []types.TokenUnit{
+		types.NewTokenUnit("udaric", 0, nil),
+		types.NewTokenUnit("daric", 6, nil),
+	}))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveTokensPrices([]types.TokenPrice{
+		types.NewTokenPrice("daric", 2, 100, block.Timestamp.Add(-time.Hour)),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBalanceChanges([]types.BalanceChange{
+		types.NewBalanceChange(address.String(), "udaric", sdk.NewInt(-100),
+			types.BalanceChangeCauseTransfer, 10, "hash"),
+	})
+	suite.Require().NoError(err)
+
+	rows, err := suite.database.GetAccountStatement(
+		address.String(),
+		block.Timestamp.Add(-time.Hour),
+		block.Timestamp.Add(time.Hour),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("hash", rows[0].TxHash)
+	suite.Require().Equal("memo", rows[0].Memo)
+	suite.Require().Equal([]string{"cosmos.bank.v1beta1.MsgSend"}, []string(rows[0].MessageTypes))
+	suite.Require().Equal("-100", rows[0].Amount)
+	suite.Require().Equal("900", rows[0].Balance.String)
+
+	fiatValue, err := sdk.NewDecFromStr(rows[0].FiatValue.String)
+	suite.Require().NoError(err)
+	suite.Require().True(fiatValue.Equal(sdk.NewDecWithPrec(-2, 4)))
+
+	// Make sure no entry is returned outside the given range
+	rows, err = suite.database.GetAccountStatement(
+		address.String(),
+		block.Timestamp.Add(time.Hour),
+		block.Timestamp.Add(2*time.Hour),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+}
+
 func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
+	suite.getBlock(9)
+	suite.getBlock(10)
+	suite.getBlock(20)
+
 	// Save the data
 	original := sdk.NewCoins(
 		sdk.NewCoin("desmos", sdk.NewInt(10000)),
@@ -183,6 +288,44 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, 1, "supply table should contain only one row")
 	suite.Require().True(expected.Equals(rows[0]))
+
+	// Verify the history
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT count(*) FROM supply_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, count, "one history row should be stored for each height")
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCirculatingSupply() {
+	suite.getBlock(9)
+	suite.getBlock(10)
+
+	// Save the data
+	original := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(10000)))
+	err := suite.database.SaveCirculatingSupply(original, 10)
+	suite.Require().NoError(err)
+
+	stored, err := suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().True(original.IsEqual(stored))
+
+	// ----------------------------------------------------------------------------------------------------------------
+
+	// Try updating with a lower height
+	err = suite.database.SaveCirculatingSupply(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(5))), 9)
+	suite.Require().NoError(err)
+
+	stored, err = suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().True(original.IsEqual(stored))
+
+	// Verify the history
+	var rows []bddbtypes.SupplyRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT coins, height FROM circulating_supply_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(int64(9), rows[0].Height)
+	suite.Require().Equal(int64(10), rows[1].Height)
 }
 
 func (suite *DbTestSuite) TestBigDipperDb_GetTokenNames() {
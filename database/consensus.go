@@ -41,6 +41,20 @@ func (db *Db) GetLastBlock() (*dbtypes.BlockRow, error) {
 	return &blocks[0], nil
 }
 
+// GetBlock returns the block having the given height, or nil if it has not been stored yet
+func (db *Db) GetBlock(height int64) (*dbtypes.BlockRow, error) {
+	var blocks []dbtypes.BlockRow
+	if err := db.Sqlx.Select(&blocks, `SELECT * FROM block WHERE height = $1`, height); err != nil {
+		return nil, err
+	}
+
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	return &blocks[0], nil
+}
+
 // GetLastBlockHeight returns the last block height stored inside the database
 func (db *Db) GetLastBlockHeight() (int64, error) {
 	block, err := db.GetLastBlock()
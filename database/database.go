@@ -48,6 +48,16 @@ func Builder(cfg juno.Config, codec *params.EncodingConfig) (db.Database, error)
 	}, nil
 }
 
+// WithEncodingConfig returns a copy of the database that uses the given encoding config.
+// The returned instance shares the connections pool with the original one
+func (db *Db) WithEncodingConfig(encodingConfig *params.EncodingConfig) *Db {
+	return &Db{
+		Database:            &postgresql.Database{Sql: db.Sql, EncodingConfig: encodingConfig},
+		Sqlx:                db.Sqlx,
+		storeHistoricalData: db.storeHistoricalData,
+	}
+}
+
 // IsStoreHistoricDataEnabled tells whether or not the historical data should be stored inside the database
 func (db *Db) IsStoreHistoricDataEnabled() bool {
 	return db.storeHistoricalData
@@ -8,11 +8,28 @@ import (
 	"github.com/forbole/bdjuno/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/lib/pq"
 )
 
 // SaveCommunityPool allows to save for the given height the given total amount of coins
 func (db *Db) SaveCommunityPool(coin sdk.DecCoins, height int64) error {
+	err := db.saveUpToDateCommunityPool(coin, height)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date community pool: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.saveHistoricCommunityPool(coin, height)
+		if err != nil {
+			return fmt.Errorf("error while storing community pool history: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveUpToDateCommunityPool(coin sdk.DecCoins, height int64) error {
 	query := `
 INSERT INTO community_pool(coins, height) 
 VALUES ($1, $2) 
@@ -24,6 +41,50 @@ WHERE community_pool.height <= excluded.height`
 	return err
 }
 
+func (db *Db) saveHistoricCommunityPool(coin sdk.DecCoins, height int64) error {
+	query := `
+INSERT INTO community_pool_history(coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT ON CONSTRAINT unique_community_pool_for_height DO UPDATE 
+    SET coins = excluded.coins`
+	_, err := db.Sql.Exec(query, pq.Array(dbtypes.NewDbDecCoins(coin)), height)
+	return err
+}
+
+// SaveCommunityPoolFund allows to store the given funds that have been sent to the community pool
+func (db *Db) SaveCommunityPoolFund(fund types.CommunityPoolFund) error {
+	query := `
+INSERT INTO community_pool_fund (depositor_address, amount, transaction_hash, msg_index, height)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT ON CONSTRAINT unique_community_pool_fund DO NOTHING`
+	_, err := db.Sql.Exec(query,
+		fund.Depositor, pq.Array(dbtypes.NewDbCoins(fund.Amount)), fund.TxHash, fund.MsgIndex, fund.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing community pool fund: %s", err)
+	}
+
+	return nil
+}
+
+// SaveCommunityPoolSpend allows to store the given funds that have been spent from the community pool
+func (db *Db) SaveCommunityPoolSpend(spend types.CommunityPoolSpend) error {
+	query := `
+INSERT INTO community_pool_spend (proposal_id, recipient_address, amount, height)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (proposal_id) DO UPDATE
+	SET recipient_address = excluded.recipient_address,
+	    amount = excluded.amount,
+	    height = excluded.height
+WHERE community_pool_spend.height <= excluded.height`
+	_, err := db.Sql.Exec(query,
+		spend.ProposalID, spend.Recipient, pq.Array(dbtypes.NewDbCoins(spend.Amount)), spend.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing community pool spend: %s", err)
+	}
+
+	return nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // SaveDistributionParams allows to store the given distribution parameters inside the database
@@ -41,7 +102,49 @@ WHERE distribution_params.height <= excluded.height`
 	_, err := db.Sql.Exec(stmt,
 		params.CommunityTax.String(), params.BaseProposerReward.String(), params.BonusProposerReward.String(),
 		params.WithdrawAddrEnabled, params.Height)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return db.saveProtoParamsHistory(distrtypes.ModuleName, &params.Params, params.Height)
+}
+
+// GetDistributionParams returns the types.DistributionParams instance containing the current params
+func (db *Db) GetDistributionParams() (*types.DistributionParams, error) {
+	var rows []dbtypes.DistributionParamsRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM distribution_params LIMIT 1`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no distribution params found")
+	}
+
+	communityTax, err := sdk.NewDecFromStr(rows[0].CommunityTax)
+	if err != nil {
+		return nil, err
+	}
+
+	baseProposerReward, err := sdk.NewDecFromStr(rows[0].BaseProposerReward)
+	if err != nil {
+		return nil, err
+	}
+
+	bonusProposerReward, err := sdk.NewDecFromStr(rows[0].BonusProposerReward)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DistributionParams{
+		Params: distrtypes.Params{
+			CommunityTax:        communityTax,
+			BaseProposerReward:  baseProposerReward,
+			BonusProposerReward: bonusProposerReward,
+			WithdrawAddrEnabled: rows[0].WithdrawAddressEnabled,
+		},
+		Height: rows[0].Height,
+	}, nil
 }
 
 // -------------------------------------------------------------------------------------------------------------------
@@ -176,3 +279,128 @@ ON CONFLICT ON CONSTRAINT delegation_reward_history_validator_delegator_unique D
 	_, err := db.Sql.Exec(stmt, params...)
 	return err
 }
+
+// GetDelegationRewardsHistory returns all the delegation rewards amounts stored as historic ones for the given height
+func (db *Db) GetDelegationRewardsHistory(height int64) ([]dbtypes.DelegationRewardRow, error) {
+	stmt := `SELECT * FROM delegation_reward_history WHERE height = $1`
+
+	var rows []dbtypes.DelegationRewardRow
+	err := db.Sqlx.Select(&rows, stmt, height)
+	return rows, err
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveStakingAPR allows to store the given staking APR as the most up-to-date one
+func (db *Db) SaveStakingAPR(apr types.StakingAPR) error {
+	err := db.storeUpToDateStakingAPR(apr)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date staking APR: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.storeStakingAPRHistory(apr)
+		if err != nil {
+			return fmt.Errorf("error while storing staking APR history: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// storeUpToDateStakingAPR allows to store the given APR as the most up-to-date one
+func (db *Db) storeUpToDateStakingAPR(apr types.StakingAPR) error {
+	stmt := `
+INSERT INTO staking_apr (apr, apy, realized_apr, height) 
+VALUES ($1, $2, $3, $4) 
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET apr = excluded.apr, 
+        apy = excluded.apy,
+        realized_apr = excluded.realized_apr,
+        height = excluded.height
+WHERE staking_apr.height <= excluded.height`
+
+	_, err := db.Sql.Exec(stmt, apr.APR.String(), apr.APY.String(), dbtypes.ToNullDec(apr.RealizedAPR), apr.Height)
+	return err
+}
+
+// storeStakingAPRHistory allows to store the given APR as an historic one
+func (db *Db) storeStakingAPRHistory(apr types.StakingAPR) error {
+	stmt := `
+INSERT INTO staking_apr_history (apr, apy, realized_apr, height) 
+VALUES ($1, $2, $3, $4) 
+ON CONFLICT ON CONSTRAINT unique_staking_apr_for_height DO UPDATE 
+    SET apr = excluded.apr, 
+        apy = excluded.apy,
+        realized_apr = excluded.realized_apr`
+
+	_, err := db.Sql.Exec(stmt, apr.APR.String(), apr.APY.String(), dbtypes.ToNullDec(apr.RealizedAPR), apr.Height)
+	return err
+}
+
+// SaveValidatorsAPR allows to store the given validators APR as the most up-to-date ones
+func (db *Db) SaveValidatorsAPR(aprs []types.ValidatorAPR) error {
+	if len(aprs) == 0 {
+		return nil
+	}
+
+	err := db.storeUpToDateValidatorsAPR(aprs)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date validators APR: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.storeValidatorsAPRHistory(aprs)
+		if err != nil {
+			return fmt.Errorf("error while storing validators APR history: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// storeUpToDateValidatorsAPR allows to store the given APRs as the most up-to-date ones
+func (db *Db) storeUpToDateValidatorsAPR(aprs []types.ValidatorAPR) error {
+	stmt := `INSERT INTO validator_apr (validator_address, apr, apy, realized_apr, height) VALUES `
+	var params []interface{}
+
+	for i, apr := range aprs {
+		ai := i * 5
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d),", ai+1, ai+2, ai+3, ai+4, ai+5)
+		params = append(params,
+			apr.ValidatorConsAddr, apr.APR.String(), apr.APY.String(), dbtypes.ToNullDec(apr.RealizedAPR), apr.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ,
+	stmt += `
+ON CONFLICT (validator_address) DO UPDATE 
+	SET apr = excluded.apr,
+		apy = excluded.apy,
+		realized_apr = excluded.realized_apr,
+		height = excluded.height
+WHERE validator_apr.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
+
+// storeValidatorsAPRHistory allows to store the given APRs as historic ones
+func (db *Db) storeValidatorsAPRHistory(aprs []types.ValidatorAPR) error {
+	stmt := `INSERT INTO validator_apr_history (validator_address, apr, apy, realized_apr, height) VALUES `
+	var params []interface{}
+
+	for i, apr := range aprs {
+		ai := i * 5
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d),", ai+1, ai+2, ai+3, ai+4, ai+5)
+		params = append(params,
+			apr.ValidatorConsAddr, apr.APR.String(), apr.APY.String(), dbtypes.ToNullDec(apr.RealizedAPR), apr.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ,
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_validator_apr_for_height DO UPDATE 
+	SET apr = excluded.apr,
+		apy = excluded.apy,
+		realized_apr = excluded.realized_apr`
+	_, err := db.Sql.Exec(stmt, params...)
+	return err
+}
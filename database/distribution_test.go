@@ -1,6 +1,8 @@
 package database_test
 
 import (
+	"database/sql"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dbtypes "github.com/forbole/bdjuno/database/types"
@@ -12,6 +14,10 @@ import (
 )
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPool() {
+	suite.getBlock(5)
+	suite.getBlock(10)
+	suite.getBlock(11)
+
 	// Save data
 	original := sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(100)))
 	err := suite.database.SaveCommunityPool(original, 10)
@@ -69,6 +75,12 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPool() {
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, 1, "community_pool table should contain only one row")
 	suite.Require().True(expected.Equals(rows[0]), "updating with higher height should modify the data")
+
+	// Verify the history
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT count(*) FROM community_pool_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, count, "one history row should be stored for each height")
 }
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveDistributionParams() {
@@ -318,3 +330,157 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveDelegatorsRewardsAmounts() {
 		suite.Require().True(row.Equals(expected[index]))
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveStakingAPR() {
+	_ = suite.getBlock(9)
+	_ = suite.getBlock(10)
+
+	realized := sdk.NewDecWithPrec(11, 2)
+	err := suite.database.SaveStakingAPR(types.NewStakingAPR(
+		sdk.NewDecWithPrec(12, 2), sdk.NewDecWithPrec(127, 3), &realized, 10,
+	))
+	suite.Require().NoError(err)
+
+	// Try updating with lower height
+	err = suite.database.SaveStakingAPR(types.NewStakingAPR(
+		sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(105, 3), nil, 9,
+	))
+	suite.Require().NoError(err)
+
+	var values []string
+	err = suite.database.Sqlx.Select(&values, `SELECT apr FROM staking_apr`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"0.120000000000000000"}, values)
+
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT count(*) FROM staking_apr_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorsAPR() {
+	_ = suite.getBlock(10)
+
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	err := suite.database.SaveValidatorsAPR([]types.ValidatorAPR{
+		types.NewValidatorAPR(
+			validator.GetConsAddr(), sdk.NewDecWithPrec(108, 3), sdk.NewDecWithPrec(114, 3), nil, 10,
+		),
+	})
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		ValidatorAddress string         `db:"validator_address"`
+		APR              string         `db:"apr"`
+		RealizedAPR      sql.NullString `db:"realized_apr"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `SELECT validator_address, apr, realized_apr FROM validator_apr`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(validator.GetConsAddr(), rows[0].ValidatorAddress)
+	suite.Require().Equal("0.108000000000000000", rows[0].APR)
+	suite.Require().False(rows[0].RealizedAPR.Valid)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPoolFund() {
+	suite.getBlock(10)
+	depositor := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('hash', 10, true, '{}')`)
+	suite.Require().NoError(err)
+
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	fund := types.NewCommunityPoolFund(depositor.String(), amount, "hash", 0, 10)
+	err = suite.database.SaveCommunityPoolFund(fund)
+	suite.Require().NoError(err)
+
+	// Make sure storing it again does not duplicate it
+	err = suite.database.SaveCommunityPoolFund(fund)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolFundRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_fund`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	expected := bddbtypes.NewDbCoins(amount)
+	suite.Require().Equal(depositor.String(), rows[0].Depositor)
+	suite.Require().True(rows[0].Amount.Equal(&expected))
+	suite.Require().Equal("hash", rows[0].TxHash)
+	suite.Require().Equal(int64(0), rows[0].MsgIndex)
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPoolSpend() {
+	suite.getBlock(10)
+	suite.getBlock(11)
+	suite.getProposalRow(1)
+	recipient := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	err := suite.database.SaveCommunityPoolSpend(types.NewCommunityPoolSpend(1, recipient.String(), amount, 11))
+	suite.Require().NoError(err)
+
+	// Try updating with lower height
+	err = suite.database.SaveCommunityPoolSpend(types.NewCommunityPoolSpend(1, recipient.String(), nil, 10))
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolSpendRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_spend`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	expected := bddbtypes.NewDbCoins(amount)
+	suite.Require().Equal(int64(1), rows[0].ProposalID)
+	suite.Require().Equal(recipient.String(), rows[0].Recipient)
+	suite.Require().True(rows[0].Amount.Equal(&expected), "updating with lower height should not modify the data")
+	suite.Require().Equal(int64(11), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_CommunityPoolFlow() {
+	suite.getBlock(10)
+	suite.getBlock(11)
+	suite.getProposalRow(1)
+	account := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('hash', 11, true, '{}')`)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveCommunityPool(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(1000))), 10)
+	suite.Require().NoError(err)
+
+	// The pool receives 100 from a MsgFundCommunityPool, 30 from the fees and sends 500 to a recipient
+	err = suite.database.SaveCommunityPool(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(630))), 11)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveCommunityPoolFund(types.NewCommunityPoolFund(
+		account.String(), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), "hash", 0, 11,
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveCommunityPoolSpend(types.NewCommunityPoolSpend(
+		1, account.String(), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(500))), 11,
+	))
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolFlowRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_flow`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	row := rows[0]
+	suite.Require().Equal(int64(10), row.StartHeight)
+	suite.Require().Equal(int64(11), row.EndHeight)
+	suite.Require().Equal("uatom", row.Denom)
+	suite.Require().True(sdk.MustNewDecFromStr(row.Delta).Equal(sdk.NewDec(-370)))
+	suite.Require().True(sdk.MustNewDecFromStr(row.Funded).Equal(sdk.NewDec(100)))
+	suite.Require().True(sdk.MustNewDecFromStr(row.Spent).Equal(sdk.NewDec(500)))
+	suite.Require().True(sdk.MustNewDecFromStr(row.OtherInflows).Equal(sdk.NewDec(30)))
+}
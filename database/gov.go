@@ -1,6 +1,7 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -10,6 +11,7 @@ import (
 	"github.com/forbole/bdjuno/types"
 
 	dbtypes "github.com/forbole/bdjuno/database/types"
+	dbutils "github.com/forbole/bdjuno/database/utils"
 
 	"github.com/lib/pq"
 )
@@ -41,7 +43,20 @@ ON CONFLICT (one_row_id) DO UPDATE
 		height = excluded.height
 WHERE gov_params.height <= excluded.height`
 	_, err = db.Sql.Exec(stmt, string(depositParamsBz), string(votingParamsBz), string(tallyingParams), params.Height)
-	return err
+	if err != nil {
+		return err
+	}
+
+	historyParamsBz, err := json.Marshal(map[string]json.RawMessage{
+		"deposit_params": depositParamsBz,
+		"voting_params":  votingParamsBz,
+		"tally_params":   tallyingParams,
+	})
+	if err != nil {
+		return err
+	}
+
+	return db.saveParamsHistory(govtypes.ModuleName, historyParamsBz, params.Height)
 }
 
 // GetGovParams returns the most recent governance parameters
@@ -201,6 +216,35 @@ func (db *Db) UpdateProposal(update types.ProposalUpdate) error {
 	return err
 }
 
+// SaveProposalStatusChanges allows to store the given proposal status changes.
+// Since a proposal can reach each status only once, the earliest height at which it has been reached is kept
+func (db *Db) SaveProposalStatusChanges(changes []types.ProposalStatusChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO proposal_status_history (proposal_id, status, height, timestamp) VALUES `
+	var param []interface{}
+
+	for i, change := range changes {
+		ci := i * 4
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d),", ci+1, ci+2, ci+3, ci+4)
+		param = append(param, change.ProposalID, change.Status, change.Height, change.Timestamp)
+	}
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT ON CONSTRAINT unique_proposal_status DO UPDATE
+	SET height = excluded.height,
+		timestamp = excluded.timestamp
+WHERE proposal_status_history.height > excluded.height`
+	_, err := db.Sql.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while storing proposal status changes: %s", err)
+	}
+
+	return nil
+}
+
 // SaveDeposits allows to save multiple deposits
 func (db *Db) SaveDeposits(deposits []types.Deposit) error {
 	if len(deposits) == 0 {
@@ -229,6 +273,45 @@ WHERE proposal_deposit.height <= excluded.height`
 	return err
 }
 
+// SaveDepositHistory allows to store the given deposit, that has been made inside the transaction having the
+// given hash. Differently from SaveDeposits, the amount is the one deposited inside the single transaction
+func (db *Db) SaveDepositHistory(deposit types.Deposit, txHash string) error {
+	query := `
+INSERT INTO proposal_deposit_history (proposal_id, depositor_address, amount, transaction_hash, height) 
+VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT ON CONSTRAINT unique_deposit_history DO UPDATE
+	SET amount = excluded.amount`
+	_, err := db.Sql.Exec(query,
+		deposit.ProposalID,
+		deposit.Depositor,
+		pq.Array(dbtypes.NewDbCoins(deposit.Amount)),
+		txHash,
+		deposit.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing deposit history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveDepositOutcome allows to store the outcome of the deposits of an ended proposal
+func (db *Db) SaveDepositOutcome(outcome types.DepositOutcome) error {
+	query := `
+INSERT INTO proposal_deposit_outcome (proposal_id, outcome, height) 
+VALUES ($1, $2, $3) 
+ON CONFLICT (proposal_id) DO UPDATE
+	SET outcome = excluded.outcome,
+		height = excluded.height
+WHERE proposal_deposit_outcome.height <= excluded.height`
+	_, err := db.Sql.Exec(query, outcome.ProposalID, outcome.Outcome, outcome.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing deposit outcome: %s", err)
+	}
+
+	return nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveVote allows to save for the given height and the message vote
@@ -249,6 +332,28 @@ WHERE proposal_vote.height <= excluded.height`
 	return err
 }
 
+// SaveVoteHistory allows to store the given vote, that has been cast inside the transaction having the given hash.
+// Differently from SaveVote, all the votes are kept so that vote changes can be tracked
+func (db *Db) SaveVoteHistory(vote types.Vote, txHash string) error {
+	query := `
+INSERT INTO proposal_vote_history (proposal_id, voter_address, option, transaction_hash, height) 
+VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT ON CONSTRAINT unique_vote_history DO UPDATE
+	SET option = excluded.option`
+	_, err := db.Sql.Exec(query,
+		vote.ProposalID,
+		vote.Voter,
+		vote.Option.String(),
+		txHash,
+		vote.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing vote history: %s", err)
+	}
+
+	return nil
+}
+
 // SaveTallyResults allows to save for the given height the given total amount of coins
 func (db *Db) SaveTallyResults(tallys []types.TallyResult) error {
 	if len(tallys) == 0 {
@@ -280,6 +385,121 @@ WHERE proposal_tally_result.height <= excluded.height`
 	return err
 }
 
+// GetProposalVotes returns the latest votes that have been cast towards the proposal having the given id
+func (db *Db) GetProposalVotes(proposalID uint64) ([]types.Vote, error) {
+	var rows []dbtypes.VoteRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM proposal_vote WHERE proposal_id = $1`, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]types.Vote, len(rows))
+	for index, row := range rows {
+		votes[index] = types.NewVote(
+			uint64(row.ProposalID),
+			row.Voter,
+			govtypes.VoteOption(govtypes.VoteOption_value[row.Option]),
+			row.Height,
+		)
+	}
+
+	return votes, nil
+}
+
+// SaveProposalValidatorTallies allows to store the given validators effective tallies
+func (db *Db) SaveProposalValidatorTallies(tallies []types.ProposalValidatorTally) error {
+	if len(tallies) == 0 {
+		return nil
+	}
+
+	query := `
+INSERT INTO proposal_validator_tally 
+    (proposal_id, validator_address, option, total_power, overridden_power, effective_power, height) VALUES `
+	var param []interface{}
+
+	for i, tally := range tallies {
+		ti := i * 7
+		query += fmt.Sprintf("($%d,$%d,NULLIF($%d, ''),$%d,$%d,$%d,$%d),", ti+1, ti+2, ti+3, ti+4, ti+5, ti+6, ti+7)
+		param = append(param, tally.ProposalID,
+			tally.ValidatorAddress,
+			tally.Option,
+			tally.TotalPower.String(),
+			tally.OverriddenPower.String(),
+			tally.EffectivePower.String(),
+			tally.Height,
+		)
+	}
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT ON CONSTRAINT unique_proposal_validator_tally DO UPDATE 
+	SET option = excluded.option, 
+	    total_power = excluded.total_power, 
+	    overridden_power = excluded.overridden_power, 
+	    effective_power = excluded.effective_power,
+	    height = excluded.height
+WHERE proposal_validator_tally.height <= excluded.height`
+	_, err := db.Sql.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while storing proposal validator tallies: %s", err)
+	}
+
+	return nil
+}
+
+// SaveProposalDelegatorTallies allows to store the given delegators effective tallies
+func (db *Db) SaveProposalDelegatorTallies(tallies []types.ProposalDelegatorTally) error {
+	paramsNumber := 8
+	slices := dbutils.SplitProposalDelegatorTallies(tallies, paramsNumber)
+
+	for _, tallies := range slices {
+		if len(tallies) == 0 {
+			continue
+		}
+
+		err := db.saveProposalDelegatorTallies(paramsNumber, tallies)
+		if err != nil {
+			return fmt.Errorf("error while storing proposal delegator tallies: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveProposalDelegatorTallies(paramsNumber int, tallies []types.ProposalDelegatorTally) error {
+	query := `
+INSERT INTO proposal_delegator_tally (
+	proposal_id, delegator_address, validator_address, power, 
+	delegator_option, validator_option, effective_option, height
+) VALUES `
+	var param []interface{}
+
+	for i, tally := range tallies {
+		ti := i * paramsNumber
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,NULLIF($%d, ''),NULLIF($%d, ''),NULLIF($%d, ''),$%d),",
+			ti+1, ti+2, ti+3, ti+4, ti+5, ti+6, ti+7, ti+8)
+		param = append(param, tally.ProposalID,
+			tally.DelegatorAddress,
+			tally.ValidatorAddress,
+			tally.Power.String(),
+			tally.DelegatorOption,
+			tally.ValidatorOption,
+			tally.EffectiveOption,
+			tally.Height,
+		)
+	}
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT ON CONSTRAINT unique_proposal_delegator_tally DO UPDATE 
+	SET power = excluded.power, 
+	    delegator_option = excluded.delegator_option, 
+	    validator_option = excluded.validator_option, 
+	    effective_option = excluded.effective_option,
+	    height = excluded.height
+WHERE proposal_delegator_tally.height <= excluded.height`
+	_, err := db.Sql.Exec(query, param...)
+	return err
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveProposalStakingPoolSnapshot allows to save the given snapshot of the staking pool
@@ -299,6 +519,59 @@ WHERE proposal_staking_pool_snapshot.height <= excluded.height`
 	return err
 }
 
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveTallyProjection allows to store the given tally projection, replacing the older one of the same proposal.
+// When the historic data is enabled, the projection is also stored inside the projections history
+func (db *Db) SaveTallyProjection(projection types.ProposalTallyProjection) error {
+	stmt := `
+INSERT INTO proposal_tally_projection (
+	proposal_id, turnout, yes_ratio, veto_ratio, quorum_reached, threshold_reached, vetoed, projected_outcome, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (proposal_id) DO UPDATE 
+	SET turnout = excluded.turnout,
+	    yes_ratio = excluded.yes_ratio,
+	    veto_ratio = excluded.veto_ratio,
+	    quorum_reached = excluded.quorum_reached,
+	    threshold_reached = excluded.threshold_reached,
+	    vetoed = excluded.vetoed,
+	    projected_outcome = excluded.projected_outcome,
+	    height = excluded.height
+WHERE proposal_tally_projection.height <= excluded.height`
+	args := []interface{}{
+		projection.ProposalID, projection.Turnout.String(), projection.YesRatio.String(), projection.VetoRatio.String(),
+		projection.QuorumReached, projection.ThresholdReached, projection.Vetoed, projection.Outcome, projection.Height,
+	}
+
+	_, err := db.Sql.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing tally projection: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		stmt = `
+INSERT INTO proposal_tally_projection_history (
+	proposal_id, turnout, yes_ratio, veto_ratio, quorum_reached, threshold_reached, vetoed, projected_outcome, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT ON CONSTRAINT unique_tally_projection_history DO UPDATE 
+	SET turnout = excluded.turnout,
+	    yes_ratio = excluded.yes_ratio,
+	    veto_ratio = excluded.veto_ratio,
+	    quorum_reached = excluded.quorum_reached,
+	    threshold_reached = excluded.threshold_reached,
+	    vetoed = excluded.vetoed,
+	    projected_outcome = excluded.projected_outcome`
+		_, err = db.Sql.Exec(stmt, args...)
+		if err != nil {
+			return fmt.Errorf("error while storing tally projection history: %s", err)
+		}
+	}
+
+	return nil
+}
+
 // SaveProposalValidatorsStatusesSnapshots allows to save the given validator statuses snapshots
 func (db *Db) SaveProposalValidatorsStatusesSnapshots(snapshots []types.ProposalValidatorStatusSnapshot) error {
 	stmt := `
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types"
+)
+
+// SaveProposalParamChanges allows to store the parameter changes contained inside a ParameterChangeProposal
+func (db *Db) SaveProposalParamChanges(changes []types.ProposalParamChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO proposal_param_change (proposal_id, subspace, key, value) VALUES `
+	var param []interface{}
+
+	for i, change := range changes {
+		ci := i * 4
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d),", ci+1, ci+2, ci+3, ci+4)
+		param = append(param, change.ProposalID, change.Subspace, change.Key, change.Value)
+	}
+	query = query[:len(query)-1] // Remove trailing ","
+	query += ` ON CONFLICT ON CONSTRAINT unique_proposal_param_change DO NOTHING`
+
+	_, err := db.Sql.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while storing proposal param changes: %s", err)
+	}
+
+	return nil
+}
+
+// SaveProposalCommunityPoolSpend allows to store the content of a CommunityPoolSpendProposal
+func (db *Db) SaveProposalCommunityPoolSpend(spend types.ProposalCommunityPoolSpend) error {
+	query := `
+INSERT INTO proposal_community_pool_spend (proposal_id, recipient_address, amount) 
+VALUES ($1, $2, $3) 
+ON CONFLICT (proposal_id) DO NOTHING`
+	_, err := db.Sql.Exec(query, spend.ProposalID, spend.Recipient, pq.Array(dbtypes.NewDbCoins(spend.Amount)))
+	if err != nil {
+		return fmt.Errorf("error while storing proposal community pool spend: %s", err)
+	}
+
+	return nil
+}
+
+// GetProposalCommunityPoolSpend returns the content of the CommunityPoolSpendProposal having the given id,
+// or nil if the proposal does not exist or is not a CommunityPoolSpendProposal
+func (db *Db) GetProposalCommunityPoolSpend(proposalID uint64) (*types.ProposalCommunityPoolSpend, error) {
+	var rows []dbtypes.ProposalCommunityPoolSpendRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM proposal_community_pool_spend WHERE proposal_id = $1`, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	spend := types.NewProposalCommunityPoolSpend(uint64(rows[0].ProposalID), rows[0].Recipient, rows[0].Amount.ToCoins())
+	return &spend, nil
+}
+
+// SaveProposalSoftwareUpgrade allows to store the plan contained inside a SoftwareUpgradeProposal
+func (db *Db) SaveProposalSoftwareUpgrade(upgrade types.ProposalSoftwareUpgrade) error {
+	query := `
+INSERT INTO proposal_software_upgrade (proposal_id, plan_name, upgrade_height, info) 
+VALUES ($1, $2, $3, $4) 
+ON CONFLICT (proposal_id) DO NOTHING`
+	_, err := db.Sql.Exec(query, upgrade.ProposalID, upgrade.PlanName, upgrade.UpgradeHeight, upgrade.Info)
+	if err != nil {
+		return fmt.Errorf("error while storing proposal software upgrade: %s", err)
+	}
+
+	return nil
+}
+
+// SaveProposalCancelSoftwareUpgrade allows to store the fact that the proposal having the given id
+// is a CancelSoftwareUpgradeProposal
+func (db *Db) SaveProposalCancelSoftwareUpgrade(proposalID uint64) error {
+	query := `INSERT INTO proposal_cancel_software_upgrade (proposal_id) VALUES ($1) ON CONFLICT DO NOTHING`
+	_, err := db.Sql.Exec(query, proposalID)
+	if err != nil {
+		return fmt.Errorf("error while storing proposal cancel software upgrade: %s", err)
+	}
+
+	return nil
+}
+
+// SaveProposalClientUpdate allows to store the content of an IBC ClientUpdateProposal
+func (db *Db) SaveProposalClientUpdate(update types.ProposalClientUpdate) error {
+	query := `
+INSERT INTO proposal_client_update (proposal_id, client_id, header) 
+VALUES ($1, $2, $3) 
+ON CONFLICT (proposal_id) DO NOTHING`
+	_, err := db.Sql.Exec(query, update.ProposalID, update.ClientID, string(update.Header))
+	if err != nil {
+		return fmt.Errorf("error while storing proposal client update: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package database_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalParamChanges() {
+	suite.getProposalRow(1)
+
+	changes := []types.ProposalParamChange{
+		types.NewProposalParamChange(1, "staking", "MaxValidators", `"105"`),
+		types.NewProposalParamChange(1, "gov", "votingparams", `{"voting_period":"600000000000"}`),
+	}
+	err := suite.database.SaveProposalParamChanges(changes)
+	suite.Require().NoError(err)
+
+	// Make sure storing them again does not fail
+	err = suite.database.SaveProposalParamChanges(changes)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalParamChangeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_param_change ORDER BY subspace`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalParamChangeRow{
+		{ProposalID: 1, Subspace: "gov", Key: "votingparams", Value: `{"voting_period":"600000000000"}`},
+		{ProposalID: 1, Subspace: "staking", Key: "MaxValidators", Value: `"105"`},
+	}, rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalCommunityPoolSpend() {
+	suite.getProposalRow(1)
+	recipient := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	amount := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(1000)))
+	err := suite.database.SaveProposalCommunityPoolSpend(
+		types.NewProposalCommunityPoolSpend(1, recipient.String(), amount),
+	)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalCommunityPoolSpendRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_community_pool_spend`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	expected := dbtypes.NewDbCoins(amount)
+	suite.Require().Equal(recipient.String(), rows[0].Recipient)
+	suite.Require().True(rows[0].Amount.Equal(&expected))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetProposalCommunityPoolSpend() {
+	suite.getProposalRow(1)
+	suite.getProposalRow(2)
+	recipient := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	amount := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(1000)))
+	err := suite.database.SaveProposalCommunityPoolSpend(
+		types.NewProposalCommunityPoolSpend(1, recipient.String(), amount),
+	)
+	suite.Require().NoError(err)
+
+	spend, err := suite.database.GetProposalCommunityPoolSpend(1)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(spend)
+	suite.Require().Equal(uint64(1), spend.ProposalID)
+	suite.Require().Equal(recipient.String(), spend.Recipient)
+	suite.Require().True(spend.Amount.IsEqual(amount))
+
+	spend, err = suite.database.GetProposalCommunityPoolSpend(2)
+	suite.Require().NoError(err)
+	suite.Require().Nil(spend, "non community pool spend proposals should return nil")
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalSoftwareUpgrade() {
+	suite.getProposalRow(1)
+
+	err := suite.database.SaveProposalSoftwareUpgrade(types.NewProposalSoftwareUpgrade(1, "v2", 1000, "info"))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalSoftwareUpgradeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_software_upgrade`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalSoftwareUpgradeRow{
+		{ProposalID: 1, PlanName: "v2", UpgradeHeight: 1000, Info: "info"},
+	}, rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalCancelSoftwareUpgrade() {
+	suite.getProposalRow(1)
+
+	err := suite.database.SaveProposalCancelSoftwareUpgrade(1)
+	suite.Require().NoError(err)
+
+	var ids []int64
+	err = suite.database.Sqlx.Select(&ids, `SELECT proposal_id FROM proposal_cancel_software_upgrade`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{1}, ids)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalClientUpdate() {
+	suite.getProposalRow(1)
+
+	header := json.RawMessage(`{"@type":"/ibc.lightclients.tendermint.v1.Header"}`)
+	err := suite.database.SaveProposalClientUpdate(types.NewProposalClientUpdate(1, "07-tendermint-0", header))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalClientUpdateRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_client_update`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("07-tendermint-0", rows[0].ClientID)
+	suite.Require().JSONEq(string(header), rows[0].Header)
+}
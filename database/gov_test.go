@@ -1,6 +1,7 @@
 package database_test
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -315,6 +316,32 @@ func (suite *DbTestSuite) TestBigDipperDb_UpdateProposal() {
 
 // -------------------------------------------------------------------------------------------------------------------
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalStatusChanges() {
+	suite.getProposalRow(1)
+
+	depositTime := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	votingTime := time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC)
+	err := suite.database.SaveProposalStatusChanges([]types.ProposalStatusChange{
+		types.NewProposalStatusChange(1, govtypes.StatusDepositPeriod.String(), 10, depositTime),
+		types.NewProposalStatusChange(1, govtypes.StatusVotingPeriod.String(), 20, votingTime),
+	})
+	suite.Require().NoError(err)
+
+	// Make sure a later height does not replace the one at which the status has been reached first
+	err = suite.database.SaveProposalStatusChanges([]types.ProposalStatusChange{
+		types.NewProposalStatusChange(1, govtypes.StatusVotingPeriod.String(), 21, votingTime.Add(time.Minute)),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalStatusChangeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_status_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalStatusChangeRow{
+		{ProposalID: 1, Status: govtypes.StatusDepositPeriod.String(), Height: 10, Timestamp: depositTime},
+		{ProposalID: 1, Status: govtypes.StatusVotingPeriod.String(), Height: 20, Timestamp: votingTime},
+	}, rows)
+}
+
 func (suite *DbTestSuite) TestBigDipperDb_SaveDeposits() {
 	_ = suite.getBlock(9)
 	_ = suite.getBlock(10)
@@ -383,6 +410,56 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveDeposits() {
 	}
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveDepositHistory() {
+	_ = suite.getBlock(10)
+	_ = suite.getBlock(11)
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('first', 10, true, '{}'), ('second', 11, true, '{}')`)
+	suite.Require().NoError(err)
+
+	suite.getProposalRow(1)
+	depositor := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	first := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(10)))
+	err = suite.database.SaveDepositHistory(types.NewDeposit(1, depositor.String(), first, 10), "first")
+	suite.Require().NoError(err)
+
+	second := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(5)))
+	err = suite.database.SaveDepositHistory(types.NewDeposit(1, depositor.String(), second, 11), "second")
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.DepositHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_deposit_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	firstCoins, secondCoins := dbtypes.NewDbCoins(first), dbtypes.NewDbCoins(second)
+	suite.Require().Equal("first", rows[0].TxHash)
+	suite.Require().True(rows[0].Amount.Equal(&firstCoins))
+	suite.Require().Equal("second", rows[1].TxHash)
+	suite.Require().True(rows[1].Amount.Equal(&secondCoins))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDepositOutcome() {
+	_ = suite.getBlock(9)
+	_ = suite.getBlock(10)
+	suite.getProposalRow(1)
+
+	err := suite.database.SaveDepositOutcome(types.NewDepositOutcome(1, types.DepositOutcomeRefunded, 10))
+	suite.Require().NoError(err)
+
+	// Make sure an older outcome does not replace the newer one
+	err = suite.database.SaveDepositOutcome(types.NewDepositOutcome(1, types.DepositOutcomeBurned, 9))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.DepositOutcomeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_deposit_outcome`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.DepositOutcomeRow{
+		{ProposalID: 1, Outcome: types.DepositOutcomeRefunded, Height: 10},
+	}, rows)
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveVote() {
@@ -443,6 +520,35 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveVote() {
 	suite.Require().True(expected.Equals(result[0]))
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveVoteHistory() {
+	_ = suite.getBlock(10)
+	_ = suite.getBlock(11)
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('first', 10, true, '{}'), ('second', 11, true, '{}')`)
+	suite.Require().NoError(err)
+
+	suite.getProposalRow(1)
+	voter := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	err = suite.database.SaveVoteHistory(types.NewVote(1, voter.String(), govtypes.OptionYes, 10), "first")
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveVoteHistory(types.NewVote(1, voter.String(), govtypes.OptionNo, 11), "second")
+	suite.Require().NoError(err)
+
+	// Make sure storing the same vote twice does not duplicate it
+	err = suite.database.SaveVoteHistory(types.NewVote(1, voter.String(), govtypes.OptionNo, 11), "second")
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.VoteHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_vote_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.VoteHistoryRow{
+		{ProposalID: 1, Voter: voter.String(), Option: govtypes.OptionYes.String(), TxHash: "first", Height: 10},
+		{ProposalID: 1, Voter: voter.String(), Option: govtypes.OptionNo.String(), TxHash: "second", Height: 11},
+	}, rows)
+}
+
 func (suite *DbTestSuite) TestBigDipperDb_SaveTallyResults() {
 	suite.getProposalRow(1)
 	suite.getProposalRow(2)
@@ -801,3 +907,174 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveProposalValidatorsStatusesSnapshot
 		),
 	})
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_GetProposalVotes() {
+	_ = suite.getBlock(1)
+	suite.getProposalRow(1)
+	voter := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	err := suite.database.SaveVote(types.NewVote(1, voter.String(), govtypes.OptionNoWithVeto, 1))
+	suite.Require().NoError(err)
+
+	votes, err := suite.database.GetProposalVotes(1)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Vote{
+		types.NewVote(1, voter.String(), govtypes.OptionNoWithVeto, 1),
+	}, votes)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalValidatorTallies() {
+	suite.getProposalRow(1)
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	err := suite.database.SaveProposalValidatorTallies([]types.ProposalValidatorTally{
+		types.NewProposalValidatorTally(1, validator.GetConsAddr(), govtypes.OptionYes.String(),
+			sdk.NewInt(100), sdk.NewInt(40), sdk.NewInt(60), 10),
+	})
+	suite.Require().NoError(err)
+
+	// Make sure older tallies do not replace newer ones
+	err = suite.database.SaveProposalValidatorTallies([]types.ProposalValidatorTally{
+		types.NewProposalValidatorTally(1, validator.GetConsAddr(), "",
+			sdk.NewInt(100), sdk.NewInt(100), sdk.ZeroInt(), 9),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalValidatorTallyRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_validator_tally`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalValidatorTallyRow{
+		{
+			ProposalID:       1,
+			ValidatorAddress: validator.GetConsAddr(),
+			Option:           sql.NullString{String: govtypes.OptionYes.String(), Valid: true},
+			TotalPower:       "100",
+			OverriddenPower:  "40",
+			EffectivePower:   "60",
+			Height:           10,
+		},
+	}, rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalDelegatorTallies() {
+	suite.getProposalRow(1)
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	delegator := suite.getAccount("cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a")
+
+	yes := govtypes.OptionYes.String()
+	err := suite.database.SaveProposalDelegatorTallies([]types.ProposalDelegatorTally{
+		types.NewProposalDelegatorTally(1, delegator.String(), validator.GetConsAddr(), sdk.NewInt(100), "", yes, yes, 10),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalDelegatorTallyRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_delegator_tally`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalDelegatorTallyRow{
+		{
+			ProposalID:       1,
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator.GetConsAddr(),
+			Power:            "100",
+			DelegatorOption:  sql.NullString{},
+			ValidatorOption:  sql.NullString{String: yes, Valid: true},
+			EffectiveOption:  sql.NullString{String: yes, Valid: true},
+			Height:           10,
+		},
+	}, rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTallyProjection() {
+	_ = suite.getBlock(9)
+	_ = suite.getBlock(10)
+	suite.getProposalRow(1)
+
+	passed := types.NewProposalTallyProjection(
+		1, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(8, 1), sdk.ZeroDec(),
+		true, true, false, govtypes.StatusPassed.String(), 10,
+	)
+	err := suite.database.SaveTallyProjection(passed)
+	suite.Require().NoError(err)
+
+	// Make sure an older projection does not replace the newer one, but it is kept inside the history
+	rejected := types.NewProposalTallyProjection(
+		1, sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(8, 1), sdk.ZeroDec(),
+		false, true, false, govtypes.StatusRejected.String(), 9,
+	)
+	err = suite.database.SaveTallyProjection(rejected)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalTallyProjectionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_projection`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(govtypes.StatusPassed.String(), rows[0].ProjectedOutcome)
+	suite.Require().True(rows[0].QuorumReached)
+	suite.Require().Equal(int64(10), rows[0].Height)
+
+	var outcomes []string
+	err = suite.database.Sqlx.Select(&outcomes,
+		`SELECT projected_outcome FROM proposal_tally_projection_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{govtypes.StatusRejected.String(), govtypes.StatusPassed.String()}, outcomes)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ValidatorGovParticipation() {
+	_ = suite.getBlock(10)
+	_, err := suite.database.Sql.Exec(`UPDATE block SET timestamp = '2020-01-01 03:00:00' WHERE height = 10`)
+	suite.Require().NoError(err)
+
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	_ = suite.getBlock(9)
+	_, err = suite.database.Sql.Exec(`UPDATE block SET timestamp = '2020-01-01 01:30:00' WHERE height = 9`)
+	suite.Require().NoError(err)
+
+	// All the proposals have their voting period starting at 2020-01-01 02:00:00
+	suite.getProposalRow(1)
+	suite.getProposalRow(2)
+	suite.getProposalRow(3)
+	suite.getProposalRow(4)
+	err = suite.database.SaveProposalValidatorsStatusesSnapshots([]types.ProposalValidatorStatusSnapshot{
+		types.NewProposalValidatorStatusSnapshot(1, validator.GetConsAddr(), 100, 3, false, 10),
+		types.NewProposalValidatorStatusSnapshot(2, validator.GetConsAddr(), 100, 3, false, 10),
+
+		// Taken during the deposit period
+		types.NewProposalValidatorStatusSnapshot(3, validator.GetConsAddr(), 100, 3, false, 9),
+
+		// Taken while the validator was not bonded
+		types.NewProposalValidatorStatusSnapshot(4, validator.GetConsAddr(), 100, 2, true, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveVote(types.NewVote(1, validator.GetSelfDelegateAddress(), govtypes.OptionYes, 10))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ValidatorGovParticipationRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_gov_participation`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ValidatorGovParticipationRow{
+		{
+			ValidatorAddress:    validator.GetConsAddr(),
+			OperatorAddress:     validator.GetOperator(),
+			SelfDelegateAddress: validator.GetSelfDelegateAddress(),
+			ProposalsEligible:   2,
+			ProposalsVoted:      1,
+			ParticipationRate:   0.5,
+			YesVotes:            1,
+			AverageTimeToVote:   sql.NullString{String: "01:00:00", Valid: true},
+		},
+	}, rows)
+}
@@ -0,0 +1,237 @@
+package database
+
+import (
+	"fmt"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types"
+)
+
+// SaveIBCTransfer allows to store the given transfer, along with the status change that has happened
+// inside the transaction having the given hash.
+// Once a transfer has reached a final status, it is never brought back to the pending one
+func (db *Db) SaveIBCTransfer(transfer types.IBCTransfer, txHash string) error {
+	stmt := `
+INSERT INTO ibc_transfer (
+	direction, port, channel, counterparty_port, counterparty_channel, sequence, 
+	sender, receiver, denom, amount, status, error, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT ON CONSTRAINT unique_ibc_transfer DO UPDATE 
+	SET status = excluded.status,
+	    error = excluded.error,
+	    height = excluded.height
+WHERE excluded.status <> 'pending' AND ibc_transfer.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt,
+		transfer.Direction, transfer.Port, transfer.Channel, transfer.CounterpartyPort, transfer.CounterpartyChannel,
+		transfer.Sequence, transfer.Sender, transfer.Receiver, transfer.Denom, transfer.Amount,
+		transfer.Status, dbtypes.ToNullString(transfer.Error), transfer.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing IBC transfer: %s", err)
+	}
+
+	stmt = `
+INSERT INTO ibc_transfer_event (direction, port, channel, sequence, status, transaction_hash, height) 
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT ON CONSTRAINT unique_ibc_transfer_event DO NOTHING`
+	_, err = db.Sql.Exec(stmt,
+		transfer.Direction, transfer.Port, transfer.Channel, transfer.Sequence,
+		transfer.Status, txHash, transfer.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing IBC transfer event: %s", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveIBCDenomTraces allows to store the given denom traces, adding each IBC denom
+// to the aliases of the token unit having the same base denom
+func (db *Db) SaveIBCDenomTraces(traces []types.IBCDenomTrace) error {
+	if len(traces) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO ibc_denom_trace (denom, path, base_denom) VALUES `
+	var params []interface{}
+
+	for i, trace := range traces {
+		ti := i * 3
+		stmt += fmt.Sprintf("($%d, $%d, $%d),", ti+1, ti+2, ti+3)
+		params = append(params, trace.Denom, trace.Path, trace.BaseDenom)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += " ON CONFLICT DO NOTHING"
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing IBC denom traces: %s", err)
+	}
+
+	return db.linkIBCDenomTraces()
+}
+
+// linkIBCDenomTraces adds all the known IBC denoms to the aliases of the token units having their base denom
+func (db *Db) linkIBCDenomTraces() error {
+	stmt := `
+UPDATE token_unit
+SET aliases = COALESCE(token_unit.aliases, '{}') || traces.denoms
+FROM (
+    SELECT token_unit.denom AS unit_denom, array_agg(ibc_denom_trace.denom) AS denoms
+    FROM token_unit
+             JOIN ibc_denom_trace ON token_unit.denom = ibc_denom_trace.base_denom OR
+                                     ibc_denom_trace.base_denom = ANY (token_unit.aliases)
+    WHERE NOT ibc_denom_trace.denom = ANY (COALESCE(token_unit.aliases, '{}'))
+    GROUP BY token_unit.denom
+) AS traces
+WHERE token_unit.denom = traces.unit_denom`
+	_, err := db.Sql.Exec(stmt)
+	return err
+}
+
+// GetUnresolvedIBCDenoms returns all the IBC denoms that are present inside the
+// supply or the accounts balances and whose trace is not yet known
+func (db *Db) GetUnresolvedIBCDenoms() ([]string, error) {
+	stmt := `
+SELECT DISTINCT coin.denom
+FROM (
+         SELECT (unnest(coins)).denom AS denom FROM supply
+         UNION
+         SELECT (unnest(coins)).denom AS denom FROM account_balance
+     ) AS coin
+WHERE coin.denom LIKE 'ibc/%'
+  AND NOT EXISTS(SELECT 1 FROM ibc_denom_trace WHERE ibc_denom_trace.denom = coin.denom)
+ORDER BY coin.denom`
+
+	var denoms []string
+	err := db.Sqlx.Select(&denoms, stmt)
+	return denoms, err
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveIBCClient allows to store the given IBC client, replacing any existing older value
+func (db *Db) SaveIBCClient(client types.IBCClient) error {
+	stmt := `
+INSERT INTO ibc_client (
+	client_id, client_type, counterparty_chain_id, trusting_period, 
+	latest_height, latest_timestamp, expiry_time, frozen, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (client_id) DO UPDATE 
+	SET client_type = excluded.client_type,
+	    counterparty_chain_id = excluded.counterparty_chain_id,
+	    trusting_period = excluded.trusting_period,
+	    latest_height = excluded.latest_height,
+	    latest_timestamp = excluded.latest_timestamp,
+	    expiry_time = excluded.expiry_time,
+	    frozen = excluded.frozen,
+	    height = excluded.height
+WHERE ibc_client.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt,
+		client.ClientID, client.ClientType, client.CounterpartyChainID, int64(client.TrustingPeriod.Seconds()),
+		client.LatestHeight, client.LatestTimestamp, client.ExpiryTime(), client.Frozen, client.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing IBC client: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateIBCClient allows to update the latest header tracked by an existing IBC client,
+// computing its new expiry time based on the stored trusting period
+func (db *Db) UpdateIBCClient(update types.IBCClientUpdate) error {
+	stmt := `
+UPDATE ibc_client 
+SET latest_height = $2,
+    latest_timestamp = $3,
+    expiry_time = $3 + trusting_period * INTERVAL '1 second',
+    height = $4
+WHERE client_id = $1 AND height <= $4 AND latest_height <= $2`
+	_, err := db.Sql.Exec(stmt, update.ClientID, update.LatestHeight, update.LatestTimestamp, update.Height)
+	if err != nil {
+		return fmt.Errorf("error while updating IBC client: %s", err)
+	}
+
+	return nil
+}
+
+// HasIBCClient tells whether the IBC client having the given id is stored inside the database
+func (db *Db) HasIBCClient(clientID string) (bool, error) {
+	var found []bool
+	err := db.Sqlx.Select(&found, `SELECT EXISTS(SELECT 1 FROM ibc_client WHERE client_id = $1)`, clientID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(found) > 0 && found[0], nil
+}
+
+// FreezeIBCClient allows to mark the IBC client having the given id as frozen starting from the given height
+func (db *Db) FreezeIBCClient(clientID string, height int64) error {
+	stmt := `UPDATE ibc_client SET frozen = true, height = $2 WHERE client_id = $1 AND height <= $2`
+	_, err := db.Sql.Exec(stmt, clientID, height)
+	if err != nil {
+		return fmt.Errorf("error while freezing IBC client: %s", err)
+	}
+
+	return nil
+}
+
+// SaveIBCConnection allows to store the given IBC connection.
+// Empty counterparty identifiers never override the ones that are already known
+func (db *Db) SaveIBCConnection(connection types.IBCConnection) error {
+	stmt := `
+INSERT INTO ibc_connection (
+	connection_id, client_id, state, counterparty_client_id, counterparty_connection_id, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (connection_id) DO UPDATE 
+	SET client_id = excluded.client_id,
+	    state = excluded.state,
+	    counterparty_client_id = COALESCE(excluded.counterparty_client_id, ibc_connection.counterparty_client_id),
+	    counterparty_connection_id = COALESCE(excluded.counterparty_connection_id, ibc_connection.counterparty_connection_id),
+	    height = excluded.height
+WHERE ibc_connection.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt,
+		connection.ConnectionID, connection.ClientID, connection.State,
+		dbtypes.ToNullString(connection.CounterpartyClientID),
+		dbtypes.ToNullString(connection.CounterpartyConnectionID),
+		connection.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing IBC connection: %s", err)
+	}
+
+	return nil
+}
+
+// SaveIBCChannel allows to store the given IBC channel.
+// An empty counterparty channel never overrides the one that is already known
+func (db *Db) SaveIBCChannel(channel types.IBCChannel) error {
+	stmt := `
+INSERT INTO ibc_channel (
+	port, channel, state, connection_id, counterparty_port, counterparty_channel, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT ON CONSTRAINT unique_ibc_channel DO UPDATE 
+	SET state = excluded.state,
+	    connection_id = excluded.connection_id,
+	    counterparty_port = excluded.counterparty_port,
+	    counterparty_channel = COALESCE(excluded.counterparty_channel, ibc_channel.counterparty_channel),
+	    height = excluded.height
+WHERE ibc_channel.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt,
+		channel.Port, channel.Channel, channel.State, channel.ConnectionID, channel.CounterpartyPort,
+		dbtypes.ToNullString(channel.CounterpartyChannel), channel.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing IBC channel: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lib/pq"
+
+	"github.com/forbole/bdjuno/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveIBCTransfer() {
+	suite.getBlock(10)
+	suite.getBlock(11)
+
+	_, err := suite.database.Sql.Exec(
+		`INSERT INTO transaction (hash, height, success, signatures) VALUES ('send', 10, true, '{}'), ('ack', 11, true, '{}')`)
+	suite.Require().NoError(err)
+
+	transfer := types.NewIBCTransfer(
+		types.IBCTransferDirectionOutgoing,
+		"transfer",
+		"channel-0",
+		"transfer",
+		"channel-5",
+		1,
+		"cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7",
+		"cosmos1tcpsdy9alvucwj0h23n56tey6zmrvkm7sndh9j",
+		"udaric",
+		"100",
+		types.IBCTransferStatusPending,
+		"",
+		10,
+	)
+
+	err = suite.database.SaveIBCTransfer(transfer, "send")
+	suite.Require().NoError(err)
+
+	// Acknowledge the transfer with an error
+	acknowledged := transfer
+	acknowledged.Status = types.IBCTransferStatusError
+	acknowledged.Error = "insufficient funds"
+	acknowledged.Height = 11
+
+	err = suite.database.SaveIBCTransfer(acknowledged, "ack")
+	suite.Require().NoError(err)
+
+	// Make sure the pending status does not override the final one
+	err = suite.database.SaveIBCTransfer(transfer, "send")
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		Status string `db:"status"`
+		Error  string `db:"error"`
+		Height int64  `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `SELECT status, error, height FROM ibc_transfer`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(types.IBCTransferStatusError, rows[0].Status)
+	suite.Require().Equal("insufficient funds", rows[0].Error)
+	suite.Require().Equal(int64(11), rows[0].Height)
+
+	var events []string
+	err = suite.database.Sqlx.Select(&events, `SELECT status FROM ibc_transfer_event ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{types.IBCTransferStatusPending, types.IBCTransferStatusError}, events)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveIBCDenomTraces() {
+	suite.getBlock(10)
+
+	err := suite.database.SaveToken(types.NewToken("atom", []types.TokenUnit{
+		types.NewTokenUnit("uatom", 0, nil),
+		types.NewTokenUnit("atom", 6, nil),
+	}))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveSupply(sdk.NewCoins(
+		sdk.NewCoin("udaric", sdk.NewInt(100)),
+		sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewInt(100)),
+		sdk.NewCoin("ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", sdk.NewInt(100)),
+	), 10)
+	suite.Require().NoError(err)
+
+	traces := []types.IBCDenomTrace{
+		types.NewIBCDenomTrace(
+			"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			"transfer/channel-0",
+			"uatom",
+		),
+	}
+
+	err = suite.database.SaveIBCDenomTraces(traces)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveIBCDenomTraces(traces)
+	suite.Require().NoError(err, "double denom traces insertion should return no error")
+
+	// Make sure the IBC denom has been added only once to the aliases
+	var aliases []pq.StringArray
+	err = suite.database.Sqlx.Select(&aliases, `SELECT aliases FROM token_unit WHERE denom = 'uatom'`)
+	suite.Require().NoError(err)
+	suite.Require().Len(aliases, 1)
+	suite.Require().Equal(
+		pq.StringArray{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+		aliases[0],
+	)
+
+	// Make sure only the unknown IBC denoms are returned
+	denoms, err := suite.database.GetUnresolvedIBCDenoms()
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		[]string{"ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"},
+		denoms,
+	)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveIBCRegistry() {
+	timestamp := time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	// Save the client and update it
+	ibcClient := types.NewIBCClient(
+		"07-tendermint-0", "07-tendermint", "cosmoshub-4", 14*24*time.Hour, 100, timestamp, false, 10,
+	)
+	err := suite.database.SaveIBCClient(ibcClient)
+	suite.Require().NoError(err)
+
+	err = suite.database.UpdateIBCClient(types.NewIBCClientUpdate("07-tendermint-0", 150, timestamp.Add(time.Hour), 11))
+	suite.Require().NoError(err)
+
+	// Make sure an older client state does not override the update
+	err = suite.database.SaveIBCClient(ibcClient)
+	suite.Require().NoError(err)
+
+	// Save the connection while performing the handshake
+	err = suite.database.SaveIBCConnection(types.NewIBCConnection(
+		"connection-0", "07-tendermint-0", "STATE_INIT", "07-tendermint-5", "", 10,
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveIBCConnection(types.NewIBCConnection(
+		"connection-0", "07-tendermint-0", "STATE_OPEN", "07-tendermint-5", "connection-3", 11,
+	))
+	suite.Require().NoError(err)
+
+	// Save the channel and close it
+	err = suite.database.SaveIBCChannel(types.NewIBCChannel(
+		"transfer", "channel-0", "STATE_OPEN", "connection-0", "transfer", "channel-7", 11,
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveIBCChannel(types.NewIBCChannel(
+		"transfer", "channel-0", "STATE_CLOSED", "connection-0", "transfer", "", 12,
+	))
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		Port                string    `db:"port"`
+		Channel             string    `db:"channel"`
+		State               string    `db:"state"`
+		CounterpartyChannel string    `db:"counterparty_channel"`
+		ConnectionID        string    `db:"connection_id"`
+		ClientID            string    `db:"client_id"`
+		CounterpartyChainID string    `db:"counterparty_chain_id"`
+		ExpiryTime          time.Time `db:"expiry_time"`
+		Expired             bool      `db:"expired"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT port, channel, state, counterparty_channel, connection_id, client_id, counterparty_chain_id, expiry_time, expired 
+FROM ibc_channel_expiry`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("STATE_CLOSED", rows[0].State)
+	suite.Require().Equal("channel-7", rows[0].CounterpartyChannel)
+	suite.Require().Equal("connection-0", rows[0].ConnectionID)
+	suite.Require().Equal("07-tendermint-0", rows[0].ClientID)
+	suite.Require().Equal("cosmoshub-4", rows[0].CounterpartyChainID)
+	suite.Require().True(rows[0].ExpiryTime.Equal(time.Date(2021, 1, 15, 13, 00, 00, 000, time.UTC)))
+	suite.Require().True(rows[0].Expired)
+
+	var counterpartyConnections []string
+	err = suite.database.Sqlx.Select(&counterpartyConnections, `SELECT counterparty_connection_id FROM ibc_connection`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"connection-3"}, counterpartyConnections)
+
+	// Freeze the client
+	found, err := suite.database.HasIBCClient("07-tendermint-0")
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	found, err = suite.database.HasIBCClient("07-tendermint-1")
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	err = suite.database.FreezeIBCClient("07-tendermint-0", 12)
+	suite.Require().NoError(err)
+
+	var frozen []bool
+	err = suite.database.Sqlx.Select(&frozen, `SELECT frozen FROM ibc_client`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]bool{true}, frozen)
+}
@@ -1,13 +1,32 @@
 package database
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/forbole/bdjuno/types"
 )
 
 // SaveInflation allows to store the inflation for the given block height as well as timestamp
 func (db *Db) SaveInflation(inflation sdk.Dec, height int64) error {
+	err := db.saveUpToDateInflation(inflation, height)
+	if err != nil {
+		return fmt.Errorf("error while storing up-to-date inflation: %s", err)
+	}
+
+	if db.IsStoreHistoricDataEnabled() {
+		err = db.saveHistoricInflation(inflation, height)
+		if err != nil {
+			return fmt.Errorf("error while storing inflation history: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveUpToDateInflation(inflation sdk.Dec, height int64) error {
 	stmt := `
 INSERT INTO inflation (value, height) 
 VALUES ($1, $2) 
@@ -19,6 +38,31 @@ WHERE inflation.height <= excluded.height`
 	return err
 }
 
+func (db *Db) saveHistoricInflation(inflation sdk.Dec, height int64) error {
+	stmt := `
+INSERT INTO inflation_history (value, height) 
+VALUES ($1, $2) 
+ON CONFLICT ON CONSTRAINT unique_inflation_for_height DO UPDATE 
+    SET value = excluded.value`
+	_, err := db.Sql.Exec(stmt, inflation.String(), height)
+	return err
+}
+
+// GetInflation returns the most up-to-date inflation value
+func (db *Db) GetInflation() (sdk.Dec, error) {
+	var values []string
+	err := db.Sqlx.Select(&values, `SELECT value FROM inflation`)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if len(values) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no inflation found")
+	}
+
+	return sdk.NewDecFromStr(values[0])
+}
+
 // SaveMintParams allows to store the given params inside the database
 func (db *Db) SaveMintParams(params types.MintParams) error {
 	stmt := `
@@ -36,5 +80,9 @@ WHERE mint_params.height <= excluded.height`
 	_, err := db.Sql.Exec(stmt, params.MintDenom,
 		params.InflationRateChange.String(), params.InflationMin.String(), params.InflationMax.String(),
 		params.GoalBonded.String(), params.BlocksPerYear, params.Height)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return db.saveProtoParamsHistory(minttypes.ModuleName, &params.Params, params.Height)
 }
@@ -10,6 +10,9 @@ import (
 )
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveInflation() {
+	suite.getBlock(90)
+	suite.getBlock(100)
+	suite.getBlock(110)
 
 	// Save the data
 	err := suite.database.SaveInflation(sdk.NewDecWithPrec(10050, 2), 100)
@@ -68,6 +71,12 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveInflation() {
 
 	expected = dbtypes.NewInflationRow(400.00, 110)
 	suite.Require().True(expected.Equal(rows[0]), "data should change with higher height")
+
+	// Verify the history
+	var count int
+	err = suite.database.Sqlx.Get(&count, `SELECT count(*) FROM inflation_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, count, "one history row should be stored for each height")
 }
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveMintParams() {
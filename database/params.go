@@ -0,0 +1,154 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/database/utils"
+)
+
+// saveParamsHistory stores the given params of the provided module inside the params history, but only if they
+// are different from the ones that the module had before the given height. If the change has been caused by a
+// parameter change proposal that has passed at the same height, the stored params are linked to such proposal.
+// Since the diffs depend on the entries of the nearby heights, which might be parsed concurrently, the whole update
+// is performed inside a transaction holding an advisory lock on the module
+func (db *Db) saveParamsHistory(module string, params json.RawMessage, height int64) error {
+	tx, err := db.Sqlx.Beginx()
+	if err != nil {
+		return err
+	}
+
+	err = storeParamsHistory(tx, module, params, height)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// storeParamsHistory stores the given params inside the params history using the given transaction
+func storeParamsHistory(tx *sqlx.Tx, module string, params json.RawMessage, height int64) error {
+	_, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('params_history'), hashtext($1))`, module)
+	if err != nil {
+		return fmt.Errorf("error while locking %s params history: %s", module, err)
+	}
+
+	previous, err := getParamsHistoryRow(tx, `module = $1 AND height < $2 ORDER BY height DESC`, module, height)
+	if err != nil {
+		return err
+	}
+
+	var previousParams json.RawMessage
+	if previous != nil {
+		previousParams = json.RawMessage(previous.Params)
+	}
+
+	diff, err := utils.GetParamsDiff(previousParams, params)
+	if err != nil {
+		return fmt.Errorf("error while computing %s params diff: %s", module, err)
+	}
+
+	if previous != nil && len(diff) == 0 {
+		return nil
+	}
+
+	diffBz, err := json.Marshal(&diff)
+	if err != nil {
+		return err
+	}
+
+	stmt := `
+INSERT INTO params_history (module, params, diff, proposal_id, height) 
+VALUES ($1, $2, $3, (
+    SELECT change.proposal_id 
+    FROM proposal_param_change change 
+    JOIN proposal_status_history history ON history.proposal_id = change.proposal_id
+    WHERE change.subspace = $1 AND history.status = $5 AND history.height = $4
+    LIMIT 1
+), $4)
+ON CONFLICT ON CONSTRAINT unique_params_history DO UPDATE 
+	SET params = excluded.params,
+	    diff = excluded.diff,
+	    proposal_id = COALESCE(excluded.proposal_id, params_history.proposal_id)`
+	_, err = tx.Exec(stmt, module, string(params), string(diffBz), height, govtypes.StatusPassed.String())
+	if err != nil {
+		return fmt.Errorf("error while storing %s params history: %s", module, err)
+	}
+
+	return updateNextParamsHistory(tx, module, params, height)
+}
+
+// updateNextParamsHistory updates the first params history entry of the given module that comes after the provided
+// height, so that its diff is computed against the given params. If they are the same, the entry is removed instead.
+// This allows to store the params history properly even when the blocks are not parsed in order
+func updateNextParamsHistory(tx *sqlx.Tx, module string, params json.RawMessage, height int64) error {
+	next, err := getParamsHistoryRow(tx, `module = $1 AND height > $2 ORDER BY height ASC`, module, height)
+	if err != nil || next == nil {
+		return err
+	}
+
+	diff, err := utils.GetParamsDiff(params, json.RawMessage(next.Params))
+	if err != nil {
+		return fmt.Errorf("error while computing %s params diff: %s", module, err)
+	}
+
+	if len(diff) == 0 {
+		_, err = tx.Exec(`DELETE FROM params_history WHERE module = $1 AND height = $2`, module, next.Height)
+		return err
+	}
+
+	diffBz, err := json.Marshal(&diff)
+	if err != nil {
+		return err
+	}
+
+	stmt := `UPDATE params_history SET diff = $1 WHERE module = $2 AND height = $3`
+	_, err = tx.Exec(stmt, string(diffBz), module, next.Height)
+	return err
+}
+
+// getParamsHistoryRow returns the first params history row matching the given condition, or nil if none is found
+func getParamsHistoryRow(tx *sqlx.Tx, condition string, args ...interface{}) (*dbtypes.ParamsHistoryRow, error) {
+	var rows []dbtypes.ParamsHistoryRow
+	err := tx.Select(&rows, fmt.Sprintf(`SELECT * FROM params_history WHERE %s LIMIT 1`, condition), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// SetParamsHistoryProposal links the params history entries stored at the given height to the parameter change
+// proposal having the given id, if they refer to one of the subspaces that such proposal changes
+func (db *Db) SetParamsHistoryProposal(proposalID uint64, height int64) error {
+	stmt := `
+UPDATE params_history SET proposal_id = $1 
+WHERE height = $2 AND module IN (SELECT subspace FROM proposal_param_change WHERE proposal_id = $1)`
+	_, err := db.Sql.Exec(stmt, proposalID, height)
+	if err != nil {
+		return fmt.Errorf("error while setting params history proposal: %s", err)
+	}
+
+	return nil
+}
+
+// saveProtoParamsHistory stores the given params of the provided module inside the params history,
+// using their Protobuf JSON representation
+func (db *Db) saveProtoParamsHistory(module string, params proto.Message, height int64) error {
+	bz, err := db.EncodingConfig.Marshaler.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	return db.saveParamsHistory(module, bz, height)
+}
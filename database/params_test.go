@@ -0,0 +1,98 @@
+package database_test
+
+import (
+	"encoding/json"
+	"time"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/database/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func (suite *DbTestSuite) saveStakingParams(unbondingTime time.Duration, height int64) {
+	err := suite.database.SaveStakingParams(types.NewStakingParams(
+		stakingtypes.NewParams(unbondingTime, 200, 7, 10000, "uatom"),
+		height,
+	))
+	suite.Require().NoError(err)
+}
+
+func (suite *DbTestSuite) getParamsHistory() []dbtypes.ParamsHistoryRow {
+	var rows []dbtypes.ParamsHistoryRow
+	err := suite.database.Sqlx.Select(&rows, `SELECT * FROM params_history ORDER BY height`)
+	suite.Require().NoError(err)
+	return rows
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveParamsHistory() {
+	suite.saveStakingParams(time.Hour*72, 10)
+	suite.saveStakingParams(time.Hour*72, 11)
+	suite.saveStakingParams(time.Hour*48, 13)
+
+	rows := suite.getParamsHistory()
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(stakingtypes.ModuleName, rows[0].Module)
+	suite.Require().Equal(int64(10), rows[0].Height)
+	suite.Require().Equal(int64(13), rows[1].Height)
+
+	var diff utils.ParamsDiff
+	suite.Require().NoError(json.Unmarshal([]byte(rows[1].Diff), &diff))
+	suite.Require().Equal(utils.ParamsDiff{
+		"unbonding_time": {Old: "259200s", New: "172800s"},
+	}, diff)
+
+	// Make sure that parsing an older block containing the new params moves the change to its height
+	suite.saveStakingParams(time.Hour*48, 12)
+
+	rows = suite.getParamsHistory()
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(int64(10), rows[0].Height)
+	suite.Require().Equal(int64(12), rows[1].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SetParamsHistoryProposal() {
+	suite.getProposalRow(1)
+	err := suite.database.SaveProposalParamChanges([]types.ProposalParamChange{
+		types.NewProposalParamChange(1, stakingtypes.ModuleName, "UnbondingTime", `"172800000000000"`),
+	})
+	suite.Require().NoError(err)
+
+	suite.saveStakingParams(time.Hour*72, 10)
+	suite.saveStakingParams(time.Hour*48, 12)
+
+	err = suite.database.SaveProposalStatusChanges([]types.ProposalStatusChange{
+		types.NewProposalStatusChange(1, govtypes.StatusPassed.String(), 12, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SetParamsHistoryProposal(1, 12)
+	suite.Require().NoError(err)
+
+	rows := suite.getParamsHistory()
+	suite.Require().Len(rows, 2)
+	suite.Require().False(rows[0].ProposalID.Valid)
+	suite.Require().True(rows[1].ProposalID.Valid)
+	suite.Require().Equal(int64(1), rows[1].ProposalID.Int64)
+
+	// Make sure params stored after the proposal status are linked too
+	suite.getProposalRow(2)
+	err = suite.database.SaveProposalParamChanges([]types.ProposalParamChange{
+		types.NewProposalParamChange(2, stakingtypes.ModuleName, "UnbondingTime", `"86400000000000"`),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveProposalStatusChanges([]types.ProposalStatusChange{
+		types.NewProposalStatusChange(2, govtypes.StatusPassed.String(), 15, time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC)),
+	})
+	suite.Require().NoError(err)
+
+	suite.saveStakingParams(time.Hour*24, 15)
+
+	rows = suite.getParamsHistory()
+	suite.Require().Len(rows, 3)
+	suite.Require().True(rows[2].ProposalID.Valid)
+	suite.Require().Equal(int64(2), rows[2].ProposalID.Int64)
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"fmt"
 
+	dbtypes "github.com/forbole/bdjuno/database/types"
 	"github.com/forbole/bdjuno/types"
 
 	"github.com/lib/pq"
@@ -21,6 +22,17 @@ func (db *Db) GetTokenUnits() ([]string, error) {
 	return names, nil
 }
 
+// GetTokenUnitsOf returns all the units of the token that the unit having the given denom belongs to
+func (db *Db) GetTokenUnitsOf(unitDenom string) ([]dbtypes.TokenUnitRow, error) {
+	query := `
+SELECT * FROM token_unit 
+WHERE token_name = (SELECT token_name FROM token_unit WHERE denom = $1)`
+
+	var rows []dbtypes.TokenUnitRow
+	err := db.Sqlx.Select(&rows, query, unitDenom)
+	return rows, err
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveToken allows to save the given token details
@@ -43,7 +55,12 @@ func (db *Db) SaveToken(token types.Token) error {
 	query = query[:len(query)-1] // Remove trailing ","
 	query += " ON CONFLICT DO NOTHING"
 	_, err = db.Sql.Exec(query, params...)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Make sure the IBC denoms of the new units are present inside their aliases
+	return db.linkIBCDenomTraces()
 }
 
 // --------------------------------------------------------------------------------------------------------------------
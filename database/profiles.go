@@ -0,0 +1,237 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/forbole/bdjuno/types"
+)
+
+// SaveProfiles allows to store the given profiles, replacing the older ones
+func (db *Db) SaveProfiles(profiles []types.Profile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO profile (address, dtag, nickname, bio, profile_pic, cover_pic, creation_time, height) VALUES `
+	var params []interface{}
+
+	for i, profile := range profiles {
+		pi := i * 8
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d),", pi+1, pi+2, pi+3, pi+4, pi+5, pi+6, pi+7, pi+8)
+		params = append(params,
+			profile.Address, profile.DTag, profile.Nickname, profile.Bio,
+			profile.ProfilePic, profile.CoverPic, profile.CreationTime, profile.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT (address) DO UPDATE
+	SET dtag = excluded.dtag,
+	    nickname = excluded.nickname,
+	    bio = excluded.bio,
+	    profile_pic = excluded.profile_pic,
+	    cover_pic = excluded.cover_pic,
+	    creation_time = excluded.creation_time,
+	    height = excluded.height
+WHERE profile.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing profiles: %s", err)
+	}
+
+	return nil
+}
+
+// GetProfilesAddresses returns the addresses of all the accounts that have a profile stored inside the database
+func (db *Db) GetProfilesAddresses() ([]string, error) {
+	var addresses []string
+	err := db.Sqlx.Select(&addresses, `SELECT address FROM profile`)
+	return addresses, err
+}
+
+// DeleteProfile removes the profile of the given address if it is not newer than the given height.
+// As it happens on chain, the blocks and relationships created by the user as well as the
+// DTag transfer requests made towards them are deleted too
+func (db *Db) DeleteProfile(address string, height int64) error {
+	_, err := db.Sql.Exec(`DELETE FROM profile WHERE address = $1 AND height <= $2`, address, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting profile: %s", err)
+	}
+
+	err = db.DeleteUserBlocks(address, height)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteUserRelationships(address, height)
+	if err != nil {
+		return err
+	}
+
+	return db.DeleteIncomingDTagTransferRequests(address, height)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveDTagTransferRequests allows to store the given DTag transfer requests, replacing the older ones
+func (db *Db) SaveDTagTransferRequests(requests []types.DTagTransferRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO dtag_transfer_request (dtag_to_trade, sender, receiver, height) VALUES `
+	var params []interface{}
+
+	for i, request := range requests {
+		ri := i * 4
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d),", ri+1, ri+2, ri+3, ri+4)
+		params = append(params, request.DTagToTrade, request.Sender, request.Receiver, request.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_dtag_transfer_request DO UPDATE
+	SET dtag_to_trade = excluded.dtag_to_trade,
+	    height = excluded.height
+WHERE dtag_transfer_request.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing DTag transfer requests: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteDTagTransferRequest removes the DTag transfer request made by the sender towards the receiver,
+// if it is not newer than the given height
+func (db *Db) DeleteDTagTransferRequest(sender, receiver string, height int64) error {
+	stmt := `DELETE FROM dtag_transfer_request WHERE sender = $1 AND receiver = $2 AND height <= $3`
+	_, err := db.Sql.Exec(stmt, sender, receiver, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting DTag transfer request: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteIncomingDTagTransferRequests removes all the DTag transfer requests made towards the given receiver
+// that are not newer than the given height
+func (db *Db) DeleteIncomingDTagTransferRequests(receiver string, height int64) error {
+	stmt := `DELETE FROM dtag_transfer_request WHERE receiver = $1 AND height <= $2`
+	_, err := db.Sql.Exec(stmt, receiver, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting incoming DTag transfer requests: %s", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveRelationships allows to store the given relationships, replacing the older ones
+func (db *Db) SaveRelationships(relationships []types.Relationship) error {
+	if len(relationships) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO profile_relationship (creator, recipient, subspace, height) VALUES `
+	var params []interface{}
+
+	for i, relationship := range relationships {
+		ri := i * 4
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d),", ri+1, ri+2, ri+3, ri+4)
+		params = append(params, relationship.Creator, relationship.Recipient, relationship.Subspace, relationship.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_profile_relationship DO UPDATE
+	SET height = excluded.height
+WHERE profile_relationship.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing relationships: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteRelationship removes the relationship created by the creator towards the recipient inside the given
+// subspace, if it is not newer than the given height
+func (db *Db) DeleteRelationship(creator, recipient, subspace string, height int64) error {
+	stmt := `
+DELETE FROM profile_relationship
+WHERE creator = $1 AND recipient = $2 AND subspace = $3 AND height <= $4`
+	_, err := db.Sql.Exec(stmt, creator, recipient, subspace, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting relationship: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteUserRelationships removes all the relationships created by the given user
+// that are not newer than the given height
+func (db *Db) DeleteUserRelationships(creator string, height int64) error {
+	_, err := db.Sql.Exec(`DELETE FROM profile_relationship WHERE creator = $1 AND height <= $2`, creator, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting user relationships: %s", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveUserBlocks allows to store the given user blocks, replacing the older ones
+func (db *Db) SaveUserBlocks(blocks []types.UserBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO user_block (blocker, blocked, reason, subspace, height) VALUES `
+	var params []interface{}
+
+	for i, block := range blocks {
+		bi := i * 5
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d),", bi+1, bi+2, bi+3, bi+4, bi+5)
+		params = append(params, block.Blocker, block.Blocked, block.Reason, block.Subspace, block.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1]
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_user_block DO UPDATE
+	SET reason = excluded.reason,
+	    height = excluded.height
+WHERE user_block.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing user blocks: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteUserBlock removes the block of the blocked user made by the blocker inside the given subspace,
+// if it is not newer than the given height
+func (db *Db) DeleteUserBlock(blocker, blocked, subspace string, height int64) error {
+	stmt := `DELETE FROM user_block WHERE blocker = $1 AND blocked = $2 AND subspace = $3 AND height <= $4`
+	_, err := db.Sql.Exec(stmt, blocker, blocked, subspace, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting user block: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteUserBlocks removes all the blocks made by the given blocker that are not newer than the given height
+func (db *Db) DeleteUserBlocks(blocker string, height int64) error {
+	_, err := db.Sql.Exec(`DELETE FROM user_block WHERE blocker = $1 AND height <= $2`, blocker, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting user blocks: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package database_test
+
+import (
+	"time"
+
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProfiles() {
+	account := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	creationTime := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	profile := types.Profile{
+		Address:      account.String(),
+		DTag:         "dtag",
+		Nickname:     "nickname",
+		Bio:          "bio",
+		ProfilePic:   "https://profile.jpg",
+		CoverPic:     "https://cover.jpg",
+		CreationTime: creationTime,
+		Height:       10,
+	}
+	err := suite.database.SaveProfiles([]types.Profile{profile})
+	suite.Require().NoError(err)
+
+	// Make sure an older profile does not replace the newer one
+	older := profile
+	older.DTag = "older"
+	older.Height = 9
+	err = suite.database.SaveProfiles([]types.Profile{older})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProfileRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM profile`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(dbtypes.ProfileRow{
+		Address:      account.String(),
+		DTag:         "dtag",
+		Nickname:     "nickname",
+		Bio:          "bio",
+		ProfilePic:   "https://profile.jpg",
+		CoverPic:     "https://cover.jpg",
+		CreationTime: creationTime,
+		Height:       10,
+	}, rows[0])
+
+	addresses, err := suite.database.GetProfilesAddresses()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{account.String()}, addresses)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteProfile() {
+	user := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	other := suite.getAccount("cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a")
+
+	err := suite.database.SaveProfiles([]types.Profile{{
+		Address:      user.String(),
+		DTag:         "dtag",
+		CreationTime: time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+		Height:       10,
+	}})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveDTagTransferRequests([]types.DTagTransferRequest{
+		types.NewDTagTransferRequest(profilestypes.NewDTagTransferRequest("dtag", other.String(), user.String()), 10),
+		types.NewDTagTransferRequest(profilestypes.NewDTagTransferRequest("other", user.String(), other.String()), 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveRelationships([]types.Relationship{
+		types.NewRelationship(profilestypes.NewRelationship(user.String(), other.String(), "subspace"), 10),
+		types.NewRelationship(profilestypes.NewRelationship(other.String(), user.String(), "subspace"), 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveUserBlocks([]types.UserBlock{
+		types.NewUserBlock(profilestypes.NewUserBlock(user.String(), other.String(), "reason", "subspace"), 10),
+		types.NewUserBlock(profilestypes.NewUserBlock(other.String(), user.String(), "reason", "subspace"), 10),
+	})
+	suite.Require().NoError(err)
+
+	// Make sure an older deletion does not remove anything
+	err = suite.database.DeleteProfile(user.String(), 9)
+	suite.Require().NoError(err)
+
+	addresses, err := suite.database.GetProfilesAddresses()
+	suite.Require().NoError(err)
+	suite.Require().Len(addresses, 1)
+
+	// Delete the profile
+	err = suite.database.DeleteProfile(user.String(), 11)
+	suite.Require().NoError(err)
+
+	addresses, err = suite.database.GetProfilesAddresses()
+	suite.Require().NoError(err)
+	suite.Require().Empty(addresses)
+
+	var requests []dbtypes.DTagTransferRequestRow
+	err = suite.database.Sqlx.Select(&requests, `SELECT * FROM dtag_transfer_request`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.DTagTransferRequestRow{
+		{DTagToTrade: "other", Sender: user.String(), Receiver: other.String(), Height: 10},
+	}, requests)
+
+	var relationships []dbtypes.RelationshipRow
+	err = suite.database.Sqlx.Select(&relationships, `SELECT * FROM profile_relationship`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.RelationshipRow{
+		{Creator: other.String(), Recipient: user.String(), Subspace: "subspace", Height: 10},
+	}, relationships)
+
+	var blocks []dbtypes.UserBlockRow
+	err = suite.database.Sqlx.Select(&blocks, `SELECT * FROM user_block`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.UserBlockRow{
+		{Blocker: other.String(), Blocked: user.String(), Reason: "reason", Subspace: "subspace", Height: 10},
+	}, blocks)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteUserBlock() {
+	blocker := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	blocked := suite.getAccount("cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a")
+
+	err := suite.database.SaveUserBlocks([]types.UserBlock{
+		types.NewUserBlock(profilestypes.NewUserBlock(blocker.String(), blocked.String(), "reason", "subspace"), 10),
+	})
+	suite.Require().NoError(err)
+
+	// Make sure an older unblock does not remove the block
+	err = suite.database.DeleteUserBlock(blocker.String(), blocked.String(), "subspace", 9)
+	suite.Require().NoError(err)
+
+	var blocks []dbtypes.UserBlockRow
+	err = suite.database.Sqlx.Select(&blocks, `SELECT * FROM user_block`)
+	suite.Require().NoError(err)
+	suite.Require().Len(blocks, 1)
+
+	err = suite.database.DeleteUserBlock(blocker.String(), blocked.String(), "subspace", 10)
+	suite.Require().NoError(err)
+
+	blocks = nil
+	err = suite.database.Sqlx.Select(&blocks, `SELECT * FROM user_block`)
+	suite.Require().NoError(err)
+	suite.Require().Empty(blocks)
+}
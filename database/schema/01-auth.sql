@@ -1,4 +1,99 @@
+CREATE TYPE COIN AS
+(
+    denom  TEXT,
+    amount TEXT
+);
+
 CREATE TABLE account
 (
-    address TEXT NOT NULL PRIMARY KEY
-);
\ No newline at end of file
+    address        TEXT NOT NULL PRIMARY KEY,
+    type           TEXT,
+    pubkey         JSONB,
+    pubkey_type    TEXT,
+    account_number BIGINT,
+    sequence       BIGINT,
+    module_name    TEXT,
+    height         BIGINT
+);
+CREATE INDEX account_type_index ON account (type);
+
+/* ---- VESTING ACCOUNTS ---- */
+
+CREATE TABLE vesting_account
+(
+    id               SERIAL PRIMARY KEY NOT NULL,
+    type             TEXT               NOT NULL,
+    address          TEXT               NOT NULL REFERENCES account (address),
+    original_vesting COIN[]             NOT NULL DEFAULT '{}',
+    start_time       TIMESTAMP WITHOUT TIME ZONE,
+    end_time         TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE UNIQUE INDEX vesting_account_address_idx ON vesting_account (address);
+
+CREATE TABLE vesting_period
+(
+    vesting_account_id BIGINT NOT NULL REFERENCES vesting_account (id),
+    period_order       BIGINT NOT NULL,
+    length             BIGINT NOT NULL,
+    amount             COIN[] NOT NULL DEFAULT '{}'
+);
+CREATE INDEX vesting_period_vesting_account_id_index ON vesting_period (vesting_account_id);
+
+/* ---- VESTING BALANCES ---- */
+
+CREATE TABLE vesting_balance
+(
+    address           TEXT   NOT NULL REFERENCES account (address) PRIMARY KEY,
+    vested            COIN[] NOT NULL DEFAULT '{}',
+    unvested          COIN[] NOT NULL DEFAULT '{}',
+    delegated_vesting COIN[] NOT NULL DEFAULT '{}',
+    delegated_free    COIN[] NOT NULL DEFAULT '{}',
+    height            BIGINT NOT NULL
+);
+CREATE INDEX vesting_balance_height_index ON vesting_balance (height);
+
+CREATE TABLE vesting_balance_history
+(
+    address           TEXT   NOT NULL REFERENCES account (address),
+    vested            COIN[] NOT NULL DEFAULT '{}',
+    unvested          COIN[] NOT NULL DEFAULT '{}',
+    delegated_vesting COIN[] NOT NULL DEFAULT '{}',
+    delegated_free    COIN[] NOT NULL DEFAULT '{}',
+    height            BIGINT NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_vesting_balance_for_height UNIQUE (address, height)
+);
+CREATE INDEX vesting_balance_history_height_index ON vesting_balance_history (height);
+
+/* ---- MULTISIG ACCOUNTS ---- */
+
+CREATE TABLE multisig_account
+(
+    address   TEXT   NOT NULL REFERENCES account (address) PRIMARY KEY,
+    threshold INT    NOT NULL,
+    height    BIGINT NOT NULL
+);
+
+CREATE TABLE multisig_account_member
+(
+    multisig_address TEXT NOT NULL REFERENCES multisig_account (address),
+    member_address   TEXT NOT NULL REFERENCES account (address),
+    member_index     INT  NOT NULL,
+    CONSTRAINT unique_multisig_account_member UNIQUE (multisig_address, member_address)
+);
+CREATE INDEX multisig_account_member_member_address_index ON multisig_account_member (member_address);
+
+/**
+ * This table contains, for each transaction signed by a multisig account,
+ * the members of the multisig that have actually signed it.
+ */
+CREATE TABLE multisig_signature
+(
+    transaction_hash TEXT   NOT NULL REFERENCES transaction (hash),
+    multisig_address TEXT   NOT NULL REFERENCES multisig_account (address),
+    member_address   TEXT   NOT NULL REFERENCES account (address),
+    height           BIGINT NOT NULL,
+    CONSTRAINT unique_multisig_signature UNIQUE (transaction_hash, multisig_address, member_address)
+);
+CREATE INDEX multisig_signature_multisig_address_index ON multisig_signature (multisig_address);
+CREATE INDEX multisig_signature_member_address_index ON multisig_signature (member_address);
+CREATE INDEX multisig_signature_height_index ON multisig_signature (height);
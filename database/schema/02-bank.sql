@@ -1,9 +1,3 @@
-CREATE TYPE COIN AS
-(
-    denom  TEXT,
-    amount TEXT
-);
-
 /* ---- SUPPLY ---- */
 
 CREATE TABLE supply
@@ -15,6 +9,45 @@ CREATE TABLE supply
 );
 CREATE INDEX supply_height_index ON supply (height);
 
+CREATE TABLE supply_history
+(
+    coins  COIN[] NOT NULL,
+    height BIGINT NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_supply_for_height UNIQUE (height)
+);
+CREATE INDEX supply_history_height_index ON supply_history (height);
+
+/**
+  * This view contains the last known supply of each day, so that it's easy to chart the supply over time.
+  * Note that it is populated only when the historic data is stored.
+ */
+CREATE VIEW supply_daily AS
+SELECT DISTINCT ON (date_trunc('day', block.timestamp)) date_trunc('day', block.timestamp) AS date,
+                                                       supply_history.coins,
+                                                       supply_history.height
+FROM supply_history
+         JOIN block ON supply_history.height = block.height
+ORDER BY date_trunc('day', block.timestamp), supply_history.height DESC;
+
+/* ---- CIRCULATING SUPPLY ---- */
+
+CREATE TABLE circulating_supply
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    coins      COIN[]  NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX circulating_supply_height_index ON circulating_supply (height);
+
+CREATE TABLE circulating_supply_history
+(
+    coins  COIN[] NOT NULL,
+    height BIGINT NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_circulating_supply_for_height UNIQUE (height)
+);
+CREATE INDEX circulating_supply_history_height_index ON circulating_supply_history (height);
+
 /* ---- BALANCES---- */
 
 CREATE TABLE account_balance
@@ -32,4 +65,33 @@ CREATE TABLE account_balance_history
     height  BIGINT NOT NULL REFERENCES block (height),
     CONSTRAINT unique_balance_for_height UNIQUE (address, height)
 );
-CREATE INDEX account_balance_history_height_index ON account_balance_history (height);
\ No newline at end of file
+CREATE INDEX account_balance_history_height_index ON account_balance_history (height);
+/* ---- BALANCE CHANGES ---- */
+
+/**
+  * This table contains the signed amount by which the balance of an account has changed, along with the cause
+  * of such change (transfer, fee, reward, delegation, unbonding, slash or internal).
+  * Minted coins are recorded as rewards, while the internal cause identifies the movements between module accounts
+  * (eg. the fees sent to the distribution module). Community pool spends are recorded as transfers.
+  * Slashes are recorded as a negative change of the staking pool the tokens are burned from. Since the slash events
+  * do not contain the burned amount, it is computed from the change of the pool balance during the block, minus the
+  * changes recorded for it by all the other causes.
+  * Changes happening during the BeginBlock or EndBlock (eg. unbonding completions) have a NULL transaction hash.
+  * Delegations and unbonding completions also record the matching change of the staking pool, so that the changes
+  * of the module accounts reconcile with their balances.
+  * The cause is part of the unique key because the same transaction can change the balance of the same address
+  * and denom for different reasons (eg. paying the fees and sending a transfer), and each cause is stored separately.
+ */
+CREATE TABLE balance_change
+(
+    address          TEXT    NOT NULL REFERENCES account (address),
+    denom            TEXT    NOT NULL,
+    amount           NUMERIC NOT NULL,
+    cause            TEXT    NOT NULL,
+    height           BIGINT  NOT NULL REFERENCES block (height),
+    transaction_hash TEXT REFERENCES transaction (hash)
+);
+CREATE UNIQUE INDEX balance_change_unique_index ON balance_change (address, denom, height, COALESCE(transaction_hash, ''), cause);
+CREATE INDEX balance_change_address_index ON balance_change (address);
+CREATE INDEX balance_change_height_index ON balance_change (height);
+CREATE INDEX balance_change_transaction_hash_index ON balance_change (transaction_hash);
@@ -22,4 +22,24 @@ CREATE TABLE inflation
     height     BIGINT  NOT NULL,
     CONSTRAINT one_row_uni CHECK (one_row_id)
 );
-CREATE INDEX inflation_height_index ON inflation (height);
\ No newline at end of file
+CREATE INDEX inflation_height_index ON inflation (height);
+
+CREATE TABLE inflation_history
+(
+    value  DECIMAL NOT NULL,
+    height BIGINT  NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_inflation_for_height UNIQUE (height)
+);
+CREATE INDEX inflation_history_height_index ON inflation_history (height);
+
+/**
+  * This view contains the last known inflation of each day, so that it's easy to chart the inflation over time.
+  * Note that it is populated only when the historic data is stored.
+ */
+CREATE VIEW inflation_daily AS
+SELECT DISTINCT ON (date_trunc('day', block.timestamp)) date_trunc('day', block.timestamp) AS date,
+                                                       inflation_history.value,
+                                                       inflation_history.height
+FROM inflation_history
+         JOIN block ON inflation_history.height = block.height
+ORDER BY date_trunc('day', block.timestamp), inflation_history.height DESC;
\ No newline at end of file
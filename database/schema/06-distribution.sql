@@ -30,6 +30,41 @@ CREATE TABLE community_pool
 );
 CREATE INDEX community_pool_height_index ON community_pool (height);
 
+CREATE TABLE community_pool_history
+(
+    coins  DEC_COIN[] NOT NULL,
+    height BIGINT     NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_community_pool_for_height UNIQUE (height)
+);
+CREATE INDEX community_pool_history_height_index ON community_pool_history (height);
+
+/**
+  * This view contains the last known community pool of each day, so that it's easy to chart it over time.
+  * Note that it is populated only when the historic data is stored.
+ */
+CREATE VIEW community_pool_daily AS
+SELECT DISTINCT ON (date_trunc('day', block.timestamp)) date_trunc('day', block.timestamp) AS date,
+                                                       community_pool_history.coins,
+                                                       community_pool_history.height
+FROM community_pool_history
+         JOIN block ON community_pool_history.height = block.height
+ORDER BY date_trunc('day', block.timestamp), community_pool_history.height DESC;
+
+/**
+  * This table contains the funds that have been sent to the community pool using a MsgFundCommunityPool.
+ */
+CREATE TABLE community_pool_fund
+(
+    depositor_address TEXT   NOT NULL REFERENCES account (address),
+    amount            COIN[] NOT NULL DEFAULT '{}',
+    transaction_hash  TEXT   NOT NULL REFERENCES transaction (hash),
+    msg_index         BIGINT NOT NULL,
+    height            BIGINT NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_community_pool_fund UNIQUE (transaction_hash, msg_index)
+);
+CREATE INDEX community_pool_fund_depositor_address_index ON community_pool_fund (depositor_address);
+CREATE INDEX community_pool_fund_height_index ON community_pool_fund (height);
+
 /* ---- VALIDATOR COMMISSION AMOUNTS ---- */
 
 CREATE TABLE validator_commission_amount
@@ -73,4 +108,48 @@ CREATE TABLE delegation_reward_history
     CONSTRAINT delegation_reward_history_validator_delegator_unique UNIQUE (delegator_address, validator_address, height)
 );
 CREATE INDEX delegation_history_reward_delegator_address_index ON delegation_reward_history (delegator_address);
-CREATE INDEX delegation_history_reward_height_index ON delegation_reward_history (height);
\ No newline at end of file
+CREATE INDEX delegation_history_reward_height_index ON delegation_reward_history (height);
+
+/* ---- STAKING APR ---- */
+
+CREATE TABLE staking_apr
+(
+    one_row_id   BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    apr          DECIMAL NOT NULL,
+    apy          DECIMAL NOT NULL,
+    realized_apr DECIMAL,
+    height       BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX staking_apr_height_index ON staking_apr (height);
+
+CREATE TABLE staking_apr_history
+(
+    apr          DECIMAL NOT NULL,
+    apy          DECIMAL NOT NULL,
+    realized_apr DECIMAL,
+    height       BIGINT  NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_staking_apr_for_height UNIQUE (height)
+);
+CREATE INDEX staking_apr_history_height_index ON staking_apr_history (height);
+
+CREATE TABLE validator_apr
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address) PRIMARY KEY,
+    apr               DECIMAL NOT NULL,
+    apy               DECIMAL NOT NULL,
+    realized_apr      DECIMAL,
+    height            BIGINT  NOT NULL
+);
+CREATE INDEX validator_apr_height_index ON validator_apr (height);
+
+CREATE TABLE validator_apr_history
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    apr               DECIMAL NOT NULL,
+    apy               DECIMAL NOT NULL,
+    realized_apr      DECIMAL,
+    height            BIGINT  NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_validator_apr_for_height UNIQUE (validator_address, height)
+);
+CREATE INDEX validator_apr_history_height_index ON validator_apr_history (height);
@@ -25,6 +25,100 @@ CREATE TABLE proposal
 );
 CREATE INDEX proposal_proposer_address_index ON proposal (proposer_address);
 
+/**
+  * This table contains all the statuses that each proposal has reached, along with the height and time of the
+  * block at which the proposal has reached them. Proposals removed from the chain because they did not reach the
+  * minimum deposit have the PROPOSAL_STATUS_REMOVED status.
+ */
+CREATE TABLE proposal_status_history
+(
+    proposal_id INTEGER                     NOT NULL REFERENCES proposal (id),
+    status      TEXT                        NOT NULL,
+    height      BIGINT                      NOT NULL,
+    timestamp   TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CONSTRAINT unique_proposal_status UNIQUE (proposal_id, status)
+);
+CREATE INDEX proposal_status_history_proposal_id_index ON proposal_status_history (proposal_id);
+CREATE INDEX proposal_status_history_height_index ON proposal_status_history (height);
+
+/**
+  * These tables contain the breakdown of the final tally of the proposals.
+  * proposal_validator_tally contains the voting power that each bonded validator has expressed once removed the stake
+  * of the delegators that have voted themselves, while proposal_delegator_tally tells for each delegation whether the
+  * delegator has voted or has inherited the vote of its validator. Missing votes are represented by NULL options.
+ */
+CREATE TABLE proposal_validator_tally
+(
+    proposal_id       INTEGER NOT NULL REFERENCES proposal (id),
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    option            TEXT,
+    total_power       NUMERIC NOT NULL,
+    overridden_power  NUMERIC NOT NULL,
+    effective_power   NUMERIC NOT NULL,
+    height            BIGINT  NOT NULL,
+    CONSTRAINT unique_proposal_validator_tally UNIQUE (proposal_id, validator_address)
+);
+CREATE INDEX proposal_validator_tally_proposal_id_index ON proposal_validator_tally (proposal_id);
+CREATE INDEX proposal_validator_tally_validator_address_index ON proposal_validator_tally (validator_address);
+
+CREATE TABLE proposal_delegator_tally
+(
+    proposal_id       INTEGER NOT NULL REFERENCES proposal (id),
+    delegator_address TEXT    NOT NULL REFERENCES account (address),
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address),
+    power             NUMERIC NOT NULL,
+    delegator_option  TEXT,
+    validator_option  TEXT,
+    effective_option  TEXT,
+    height            BIGINT  NOT NULL,
+    CONSTRAINT unique_proposal_delegator_tally UNIQUE (proposal_id, delegator_address, validator_address)
+);
+CREATE INDEX proposal_delegator_tally_proposal_id_index ON proposal_delegator_tally (proposal_id);
+CREATE INDEX proposal_delegator_tally_delegator_address_index ON proposal_delegator_tally (delegator_address);
+CREATE INDEX proposal_delegator_tally_validator_address_index ON proposal_delegator_tally (validator_address);
+
+/* ---- PROPOSALS CONTENT ---- */
+
+CREATE TABLE proposal_param_change
+(
+    proposal_id INTEGER NOT NULL REFERENCES proposal (id),
+    subspace    TEXT    NOT NULL,
+    key         TEXT    NOT NULL,
+    value       TEXT    NOT NULL,
+    CONSTRAINT unique_proposal_param_change UNIQUE (proposal_id, subspace, key)
+);
+CREATE INDEX proposal_param_change_proposal_id_index ON proposal_param_change (proposal_id);
+CREATE INDEX proposal_param_change_subspace_key_index ON proposal_param_change (subspace, key);
+
+CREATE TABLE proposal_community_pool_spend
+(
+    proposal_id       INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id),
+    recipient_address TEXT    NOT NULL REFERENCES account (address),
+    amount            COIN[]  NOT NULL DEFAULT '{}'
+);
+CREATE INDEX proposal_community_pool_spend_recipient_address_index ON proposal_community_pool_spend (recipient_address);
+
+CREATE TABLE proposal_software_upgrade
+(
+    proposal_id    INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id),
+    plan_name      TEXT    NOT NULL,
+    upgrade_height BIGINT  NOT NULL,
+    info           TEXT    NOT NULL
+);
+
+CREATE TABLE proposal_cancel_software_upgrade
+(
+    proposal_id INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id)
+);
+
+CREATE TABLE proposal_client_update
+(
+    proposal_id INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id),
+    client_id   TEXT    NOT NULL,
+    header      JSONB   NOT NULL DEFAULT '{}'::JSONB
+);
+CREATE INDEX proposal_client_update_client_id_index ON proposal_client_update (client_id);
+
 CREATE TABLE proposal_deposit
 (
     proposal_id       INTEGER REFERENCES proposal (id) NOT NULL,
@@ -37,6 +131,36 @@ CREATE INDEX proposal_deposit_proposal_id_index ON proposal_deposit (proposal_id
 CREATE INDEX proposal_deposit_depositor_address_index ON proposal_deposit (depositor_address);
 CREATE INDEX proposal_deposit_depositor_height_index ON proposal_deposit (height);
 
+/**
+  * This table contains all the single deposits that have been made, each one with the amount deposited inside
+  * its transaction. The total amount deposited by each depositor is stored inside the proposal_deposit table.
+ */
+CREATE TABLE proposal_deposit_history
+(
+    proposal_id       INTEGER NOT NULL REFERENCES proposal (id),
+    depositor_address TEXT    NOT NULL REFERENCES account (address),
+    amount            COIN[]  NOT NULL DEFAULT '{}',
+    transaction_hash  TEXT    NOT NULL REFERENCES transaction (hash),
+    height            BIGINT  NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_deposit_history UNIQUE (transaction_hash, proposal_id, depositor_address)
+);
+CREATE INDEX proposal_deposit_history_proposal_id_index ON proposal_deposit_history (proposal_id);
+CREATE INDEX proposal_deposit_history_depositor_address_index ON proposal_deposit_history (depositor_address);
+CREATE INDEX proposal_deposit_history_height_index ON proposal_deposit_history (height);
+
+/**
+  * This table contains what happened to the deposits of each ended proposal, either refunded or burned.
+  * The height is the one of the block at which the proposal has ended. Deposits are burned when the proposal has been
+  * dropped, or when its final tally has not reached the quorum or has been vetoed.
+ */
+CREATE TABLE proposal_deposit_outcome
+(
+    proposal_id INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id),
+    outcome     TEXT    NOT NULL,
+    height      BIGINT  NOT NULL REFERENCES block (height)
+);
+CREATE INDEX proposal_deposit_outcome_height_index ON proposal_deposit_outcome (height);
+
 CREATE TABLE proposal_vote
 (
     proposal_id   INTEGER NOT NULL REFERENCES proposal (id),
@@ -49,6 +173,23 @@ CREATE INDEX proposal_vote_proposal_id_index ON proposal_vote (proposal_id);
 CREATE INDEX proposal_vote_voter_address_index ON proposal_vote (voter_address);
 CREATE INDEX proposal_vote_height_index ON proposal_vote (height);
 
+/**
+  * This table contains all the votes that have been cast, including the ones that have later been changed.
+  * The current vote of each voter is stored inside the proposal_vote table.
+ */
+CREATE TABLE proposal_vote_history
+(
+    proposal_id      INTEGER NOT NULL REFERENCES proposal (id),
+    voter_address    TEXT    NOT NULL REFERENCES account (address),
+    option           TEXT    NOT NULL,
+    transaction_hash TEXT    NOT NULL REFERENCES transaction (hash),
+    height           BIGINT  NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_vote_history UNIQUE (transaction_hash, proposal_id, voter_address)
+);
+CREATE INDEX proposal_vote_history_proposal_id_index ON proposal_vote_history (proposal_id);
+CREATE INDEX proposal_vote_history_voter_address_index ON proposal_vote_history (voter_address);
+CREATE INDEX proposal_vote_history_height_index ON proposal_vote_history (height);
+
 CREATE TABLE proposal_tally_result
 (
     proposal_id  INTEGER REFERENCES proposal (id) PRIMARY KEY,
@@ -72,6 +213,43 @@ CREATE TABLE proposal_staking_pool_snapshot
 );
 CREATE INDEX proposal_staking_pool_snapshot_proposal_id_index ON proposal_staking_pool_snapshot (proposal_id);
 
+/**
+  * This table contains the outcome that each proposal in voting period would have if its voting period ended with its
+  * current tally result, computed using the tally params and the bonded tokens of its staking pool snapshot taken
+  * at the same height of the tally result.
+  * The projections computed at each height are kept inside proposal_tally_projection_history when storing the
+  * historic data.
+ */
+CREATE TABLE proposal_tally_projection
+(
+    proposal_id       INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id),
+    turnout           DECIMAL NOT NULL,
+    yes_ratio         DECIMAL NOT NULL,
+    veto_ratio        DECIMAL NOT NULL,
+    quorum_reached    BOOLEAN NOT NULL,
+    threshold_reached BOOLEAN NOT NULL,
+    vetoed            BOOLEAN NOT NULL,
+    projected_outcome TEXT    NOT NULL,
+    height            BIGINT  NOT NULL
+);
+CREATE INDEX proposal_tally_projection_height_index ON proposal_tally_projection (height);
+
+CREATE TABLE proposal_tally_projection_history
+(
+    proposal_id       INTEGER NOT NULL REFERENCES proposal (id),
+    turnout           DECIMAL NOT NULL,
+    yes_ratio         DECIMAL NOT NULL,
+    veto_ratio        DECIMAL NOT NULL,
+    quorum_reached    BOOLEAN NOT NULL,
+    threshold_reached BOOLEAN NOT NULL,
+    vetoed            BOOLEAN NOT NULL,
+    projected_outcome TEXT    NOT NULL,
+    height            BIGINT  NOT NULL REFERENCES block (height),
+    CONSTRAINT unique_tally_projection_history UNIQUE (proposal_id, height)
+);
+CREATE INDEX proposal_tally_projection_history_proposal_id_index ON proposal_tally_projection_history (proposal_id);
+CREATE INDEX proposal_tally_projection_history_height_index ON proposal_tally_projection_history (height);
+
 CREATE TABLE proposal_validator_status_snapshot
 (
     id                SERIAL PRIMARY KEY NOT NULL,
@@ -84,4 +262,138 @@ CREATE TABLE proposal_validator_status_snapshot
     CONSTRAINT unique_validator_status_snapshot UNIQUE (proposal_id, validator_address)
 );
 CREATE INDEX proposal_validator_status_snapshot_proposal_id_index ON proposal_validator_status_snapshot (proposal_id);
-CREATE INDEX proposal_validator_status_snapshot_validator_address_index ON proposal_validator_status_snapshot (validator_address);
\ No newline at end of file
+CREATE INDEX proposal_validator_status_snapshot_validator_address_index ON proposal_validator_status_snapshot (validator_address);
+
+/* ---- VALIDATORS PARTICIPATION ---- */
+
+/**
+  * This view contains, for each validator, the proposals that it was eligible to vote on along with its current vote,
+  * the time of its first vote and how long it took to cast it since the start of the voting period.
+  * Validators are eligible to vote on the proposals whose status snapshot shows them as bonded (status 3) and was
+  * taken during the voting period, up to the block that ended it (ie. the first block whose previous one was
+  * still before the voting end time). Snapshots taken during the deposit period are ignored. The votes of the validators are the ones cast by their self delegate addresses.
+ */
+CREATE VIEW validator_proposal_vote AS
+SELECT snapshot.validator_address,
+       validator_info.operator_address,
+       validator_info.self_delegate_address,
+       proposal.id                                       AS proposal_id,
+       proposal_vote.option,
+       first_vote.timestamp                              AS first_vote_timestamp,
+       first_vote.timestamp - proposal.voting_start_time AS time_to_vote
+FROM proposal_validator_status_snapshot snapshot
+         JOIN proposal ON proposal.id = snapshot.proposal_id
+         JOIN block snapshot_block ON snapshot_block.height = snapshot.height
+         LEFT JOIN block previous_block ON previous_block.height = snapshot.height - 1
+         JOIN validator_info ON validator_info.consensus_address = snapshot.validator_address
+         LEFT JOIN proposal_vote ON proposal_vote.proposal_id = proposal.id
+    AND proposal_vote.voter_address = validator_info.self_delegate_address
+         LEFT JOIN LATERAL (
+    SELECT block.timestamp
+    FROM block
+    WHERE block.height = COALESCE((
+                                      SELECT MIN(history.height)
+                                      FROM proposal_vote_history history
+                                      WHERE history.proposal_id = proposal.id
+                                        AND history.voter_address = validator_info.self_delegate_address
+                                  ), proposal_vote.height)
+    ) first_vote ON TRUE
+WHERE proposal.status IN ('PROPOSAL_STATUS_VOTING_PERIOD', 'PROPOSAL_STATUS_PASSED',
+                          'PROPOSAL_STATUS_REJECTED', 'PROPOSAL_STATUS_FAILED')
+  AND snapshot.status = 3
+  AND snapshot_block.timestamp >= proposal.voting_start_time
+  AND (previous_block.timestamp IS NULL OR previous_block.timestamp < proposal.voting_end_time);
+
+/**
+  * This view contains the governance participation metrics of each validator, computed from validator_proposal_vote.
+ */
+CREATE VIEW validator_gov_participation AS
+SELECT validator_address,
+       operator_address,
+       self_delegate_address,
+       COUNT(*)                                                    AS proposals_eligible,
+       COUNT(option)                                               AS proposals_voted,
+       COUNT(option)::DECIMAL / COUNT(*)                           AS participation_rate,
+       COUNT(*) FILTER (WHERE option = 'VOTE_OPTION_YES')          AS yes_votes,
+       COUNT(*) FILTER (WHERE option = 'VOTE_OPTION_NO')           AS no_votes,
+       COUNT(*) FILTER (WHERE option = 'VOTE_OPTION_ABSTAIN')      AS abstain_votes,
+       COUNT(*) FILTER (WHERE option = 'VOTE_OPTION_NO_WITH_VETO') AS no_with_veto_votes,
+       AVG(time_to_vote)                                           AS average_time_to_vote
+FROM validator_proposal_vote
+GROUP BY validator_address, operator_address, self_delegate_address;
+
+
+/* ---- COMMUNITY POOL SPENDS ---- */
+
+/**
+  * This table contains the funds that have been sent from the community pool to their recipients
+  * when executing the passed community pool spend proposals.
+ */
+CREATE TABLE community_pool_spend
+(
+    proposal_id       INTEGER NOT NULL PRIMARY KEY REFERENCES proposal (id),
+    recipient_address TEXT    NOT NULL REFERENCES account (address),
+    amount            COIN[]  NOT NULL DEFAULT '{}',
+    height            BIGINT  NOT NULL REFERENCES block (height)
+);
+CREATE INDEX community_pool_spend_recipient_address_index ON community_pool_spend (recipient_address);
+CREATE INDEX community_pool_spend_height_index ON community_pool_spend (height);
+
+/**
+  * This view reconciles the changes of the community pool between each pair of consecutive community_pool_history
+  * entries with the known inflows and outflows that happened in between, for each denom.
+  * funded contains the amount sent using MsgFundCommunityPool, spent contains the amount sent to the recipients of the
+  * community pool spend proposals, while other_inflows contains the remaining difference, which represents the
+  * amounts coming from the fees and the community tax.
+  * Note that it is populated only when the historic data is stored.
+ */
+CREATE VIEW community_pool_flow AS
+WITH pool AS (
+    SELECT LAG(height) OVER (ORDER BY height) AS start_height,
+           height                             AS end_height,
+           LAG(coins) OVER (ORDER BY height)  AS start_coins,
+           coins                              AS end_coins
+    FROM community_pool_history
+),
+     pool_denom AS (
+         SELECT DISTINCT pool.start_height, pool.end_height, pool.start_coins, pool.end_coins, coin.denom
+         FROM pool,
+              UNNEST(pool.start_coins || pool.end_coins) AS coin
+         WHERE pool.start_height IS NOT NULL
+     )
+SELECT pool_denom.start_height,
+       pool_denom.end_height,
+       pool_denom.denom,
+       starting.amount                                                    AS start_amount,
+       ending.amount                                                      AS end_amount,
+       ending.amount - starting.amount                                    AS delta,
+       funded.amount                                                      AS funded,
+       spent.amount                                                       AS spent,
+       ending.amount - starting.amount - funded.amount + spent.amount     AS other_inflows
+FROM pool_denom
+         CROSS JOIN LATERAL (
+    SELECT COALESCE(SUM(coin.amount::NUMERIC), 0) AS amount
+    FROM UNNEST(pool_denom.start_coins) AS coin
+    WHERE coin.denom = pool_denom.denom
+    ) starting
+         CROSS JOIN LATERAL (
+    SELECT COALESCE(SUM(coin.amount::NUMERIC), 0) AS amount
+    FROM UNNEST(pool_denom.end_coins) AS coin
+    WHERE coin.denom = pool_denom.denom
+    ) ending
+         CROSS JOIN LATERAL (
+    SELECT COALESCE(SUM(coin.amount::NUMERIC), 0) AS amount
+    FROM community_pool_fund,
+         UNNEST(community_pool_fund.amount) AS coin
+    WHERE community_pool_fund.height > pool_denom.start_height
+      AND community_pool_fund.height <= pool_denom.end_height
+      AND coin.denom = pool_denom.denom
+    ) funded
+         CROSS JOIN LATERAL (
+    SELECT COALESCE(SUM(coin.amount::NUMERIC), 0) AS amount
+    FROM community_pool_spend,
+         UNNEST(community_pool_spend.amount) AS coin
+    WHERE community_pool_spend.height > pool_denom.start_height
+      AND community_pool_spend.height <= pool_denom.end_height
+      AND coin.denom = pool_denom.denom
+    ) spent;
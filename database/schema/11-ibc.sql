@@ -0,0 +1,136 @@
+/* ---- TRANSFERS ---- */
+
+/**
+  * This table contains all the ICS-20 transfers that have been sent or received by this chain.
+  * The port and channel columns always refer to the local chain side, while the counterparty ones refer to the
+  * other chain side. The status of each transfer is one of the following:
+  * pending, received, acknowledged, error or timeout.
+ */
+CREATE TABLE ibc_transfer
+(
+    direction            TEXT   NOT NULL,
+    port                 TEXT   NOT NULL,
+    channel              TEXT   NOT NULL,
+    counterparty_port    TEXT   NOT NULL,
+    counterparty_channel TEXT   NOT NULL,
+    sequence             BIGINT NOT NULL,
+    sender               TEXT   NOT NULL,
+    receiver             TEXT   NOT NULL,
+    denom                TEXT   NOT NULL,
+    amount               TEXT   NOT NULL,
+    status               TEXT   NOT NULL,
+    error                TEXT,
+    height               BIGINT NOT NULL,
+    CONSTRAINT unique_ibc_transfer UNIQUE (direction, port, channel, sequence)
+);
+CREATE INDEX ibc_transfer_sender_index ON ibc_transfer (sender);
+CREATE INDEX ibc_transfer_receiver_index ON ibc_transfer (receiver);
+CREATE INDEX ibc_transfer_status_index ON ibc_transfer (status);
+CREATE INDEX ibc_transfer_height_index ON ibc_transfer (height);
+
+/**
+  * This table contains the lifecycle of each transfer, storing the transaction
+  * inside which each status change of the transfer has happened.
+ */
+CREATE TABLE ibc_transfer_event
+(
+    direction        TEXT   NOT NULL,
+    port             TEXT   NOT NULL,
+    channel          TEXT   NOT NULL,
+    sequence         BIGINT NOT NULL,
+    status           TEXT   NOT NULL,
+    transaction_hash TEXT   NOT NULL REFERENCES transaction (hash),
+    height           BIGINT NOT NULL,
+    CONSTRAINT unique_ibc_transfer_event UNIQUE (direction, port, channel, sequence, status)
+);
+CREATE INDEX ibc_transfer_event_transaction_hash_index ON ibc_transfer_event (transaction_hash);
+CREATE INDEX ibc_transfer_event_height_index ON ibc_transfer_event (height);
+
+/* ---- DENOM TRACES ---- */
+
+/**
+  * This table contains the traces of all the IBC denoms (ibc/<hash>) that have been found.
+  * Each IBC denom is also added to the aliases of the token unit having its base denom, if any.
+ */
+CREATE TABLE ibc_denom_trace
+(
+    denom      TEXT NOT NULL PRIMARY KEY,
+    path       TEXT NOT NULL,
+    base_denom TEXT NOT NULL
+);
+CREATE INDEX ibc_denom_trace_base_denom_index ON ibc_denom_trace (base_denom);
+
+/* ---- CLIENTS, CONNECTIONS AND CHANNELS ---- */
+
+/**
+  * This table contains all the IBC light clients of the chain, along with the chain they track.
+  * The trusting period is expressed in seconds, and the expiry time is the time after which the client
+  * can no longer be updated if no new header is submitted before it.
+  * Clients are frozen once a misbehaviour of the chain they track is submitted.
+ */
+CREATE TABLE ibc_client
+(
+    client_id             TEXT                        NOT NULL PRIMARY KEY,
+    client_type           TEXT                        NOT NULL,
+    counterparty_chain_id TEXT                        NOT NULL,
+    trusting_period       BIGINT                      NOT NULL,
+    latest_height         BIGINT                      NOT NULL,
+    latest_timestamp      TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    expiry_time           TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    frozen                BOOLEAN                     NOT NULL DEFAULT false,
+    height                BIGINT                      NOT NULL
+);
+CREATE INDEX ibc_client_counterparty_chain_id_index ON ibc_client (counterparty_chain_id);
+CREATE INDEX ibc_client_expiry_time_index ON ibc_client (expiry_time);
+
+/**
+  * This table contains all the IBC connections of the chain.
+  * The counterparty identifiers are null until the handshake gives them a value.
+ */
+CREATE TABLE ibc_connection
+(
+    connection_id              TEXT   NOT NULL PRIMARY KEY,
+    client_id                  TEXT   NOT NULL,
+    state                      TEXT   NOT NULL,
+    counterparty_client_id     TEXT,
+    counterparty_connection_id TEXT,
+    height                     BIGINT NOT NULL
+);
+CREATE INDEX ibc_connection_client_id_index ON ibc_connection (client_id);
+
+/**
+  * This table contains all the IBC channels of the chain.
+  * The counterparty channel is null until the handshake gives it a value.
+ */
+CREATE TABLE ibc_channel
+(
+    port                 TEXT   NOT NULL,
+    channel              TEXT   NOT NULL,
+    state                TEXT   NOT NULL,
+    connection_id        TEXT   NOT NULL,
+    counterparty_port    TEXT   NOT NULL,
+    counterparty_channel TEXT,
+    height               BIGINT NOT NULL,
+    CONSTRAINT unique_ibc_channel UNIQUE (port, channel)
+);
+CREATE INDEX ibc_channel_connection_id_index ON ibc_channel (connection_id);
+
+/**
+  * This view contains all the channels along with the chain they are connected to and the expiry time
+  * of the client they rely on, so that the channels close to expiry can be easily found.
+ */
+CREATE VIEW ibc_channel_expiry AS
+SELECT ibc_channel.port,
+       ibc_channel.channel,
+       ibc_channel.state,
+       ibc_channel.counterparty_port,
+       ibc_channel.counterparty_channel,
+       ibc_connection.connection_id,
+       ibc_client.client_id,
+       ibc_client.counterparty_chain_id,
+       ibc_client.frozen,
+       ibc_client.expiry_time,
+       ibc_client.expiry_time < timezone('utc', now()) AS expired
+FROM ibc_channel
+         JOIN ibc_connection ON ibc_connection.connection_id = ibc_channel.connection_id
+         JOIN ibc_client ON ibc_client.client_id = ibc_connection.client_id;
@@ -0,0 +1,28 @@
+/**
+  * This table contains the software upgrade that is currently planned, if any.
+  * The proposal id is null when the proposal that has scheduled the upgrade is not known.
+ */
+CREATE TABLE upgrade_plan
+(
+    one_row_id     BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    name           TEXT    NOT NULL,
+    upgrade_height BIGINT  NOT NULL,
+    info           TEXT    NOT NULL,
+    proposal_id    INTEGER REFERENCES proposal (id),
+    height         BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX upgrade_plan_height_index ON upgrade_plan (height);
+
+/**
+  * This table contains all the software upgrades that have been applied.
+  * The height is the one of the block at which the chain halted and the upgrade took effect.
+ */
+CREATE TABLE applied_upgrade
+(
+    name        TEXT    NOT NULL PRIMARY KEY,
+    info        TEXT    NOT NULL,
+    proposal_id INTEGER REFERENCES proposal (id),
+    height      BIGINT  NOT NULL
+);
+CREATE INDEX applied_upgrade_height_index ON applied_upgrade (height);
@@ -0,0 +1,50 @@
+/**
+  * This table contains the Desmos profiles associated to the accounts.
+ */
+CREATE TABLE profile
+(
+    address       TEXT                        NOT NULL PRIMARY KEY REFERENCES account (address),
+    dtag          TEXT                        NOT NULL,
+    nickname      TEXT                        NOT NULL,
+    bio           TEXT                        NOT NULL,
+    profile_pic   TEXT                        NOT NULL,
+    cover_pic     TEXT                        NOT NULL,
+    creation_time TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    height        BIGINT                      NOT NULL
+);
+CREATE INDEX profile_dtag_index ON profile (dtag);
+CREATE INDEX profile_height_index ON profile (height);
+
+CREATE TABLE dtag_transfer_request
+(
+    dtag_to_trade TEXT   NOT NULL,
+    sender        TEXT   NOT NULL REFERENCES account (address),
+    receiver      TEXT   NOT NULL REFERENCES account (address),
+    height        BIGINT NOT NULL,
+    CONSTRAINT unique_dtag_transfer_request UNIQUE (sender, receiver)
+);
+CREATE INDEX dtag_transfer_request_receiver_index ON dtag_transfer_request (receiver);
+CREATE INDEX dtag_transfer_request_height_index ON dtag_transfer_request (height);
+
+CREATE TABLE profile_relationship
+(
+    creator   TEXT   NOT NULL REFERENCES account (address),
+    recipient TEXT   NOT NULL REFERENCES account (address),
+    subspace  TEXT   NOT NULL,
+    height    BIGINT NOT NULL,
+    CONSTRAINT unique_profile_relationship UNIQUE (creator, recipient, subspace)
+);
+CREATE INDEX profile_relationship_recipient_index ON profile_relationship (recipient);
+CREATE INDEX profile_relationship_height_index ON profile_relationship (height);
+
+CREATE TABLE user_block
+(
+    blocker  TEXT   NOT NULL REFERENCES account (address),
+    blocked  TEXT   NOT NULL REFERENCES account (address),
+    reason   TEXT   NOT NULL,
+    subspace TEXT   NOT NULL,
+    height   BIGINT NOT NULL,
+    CONSTRAINT unique_user_block UNIQUE (blocker, blocked, subspace)
+);
+CREATE INDEX user_block_blocked_index ON user_block (blocked);
+CREATE INDEX user_block_height_index ON user_block (height);
@@ -0,0 +1,17 @@
+/**
+  * This table contains the history of the params of the various modules.
+  * A new row is added only when the params of a module change, and it contains the diff with the previous params.
+  * When the change has been caused by a parameter change proposal, proposal_id references such proposal.
+ */
+CREATE TABLE params_history
+(
+    module      TEXT    NOT NULL,
+    params      JSONB   NOT NULL,
+    diff        JSONB   NOT NULL DEFAULT '{}'::JSONB,
+    proposal_id INTEGER REFERENCES proposal (id),
+    height      BIGINT  NOT NULL,
+    CONSTRAINT unique_params_history UNIQUE (module, height)
+);
+CREATE INDEX params_history_module_index ON params_history (module);
+CREATE INDEX params_history_proposal_id_index ON params_history (proposal_id);
+CREATE INDEX params_history_height_index ON params_history (height);
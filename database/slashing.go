@@ -3,6 +3,8 @@ package database
 import (
 	"fmt"
 
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
 	"github.com/forbole/bdjuno/types"
 )
 
@@ -59,5 +61,9 @@ WHERE slashing_params.height <= excluded.height`
 	_, err := db.Sql.Exec(stmt,
 		params.SignedBlocksWindow, params.MinSignedPerWindow.String(), params.DowntimeJailDuration,
 		params.SlashFractionDoubleSign.String(), params.SlashFractionDowntime.String(), params.Height)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return db.saveProtoParamsHistory(slashingtypes.ModuleName, &params.Params, params.Height)
 }
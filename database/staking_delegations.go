@@ -157,6 +157,20 @@ func (db *Db) GetDelegators() ([]string, error) {
 	return rows, nil
 }
 
+// GetDelegationsAtHeight returns the delegations that existed at the given height,
+// taking for each of them the latest amount stored inside the delegations history
+func (db *Db) GetDelegationsAtHeight(height int64) ([]dbtypes.DelegationRow, error) {
+	stmt := `
+SELECT DISTINCT ON (validator_address, delegator_address) validator_address, delegator_address, amount, height
+FROM delegation_history 
+WHERE height <= $1 
+ORDER BY validator_address, delegator_address, height DESC`
+
+	var rows []dbtypes.DelegationRow
+	err := db.Sqlx.Select(&rows, stmt, height)
+	return rows, err
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveRedelegations saves the given redelegations inside the database.
@@ -157,6 +157,39 @@ func (suite *DbTestSuite) TestDelegations() {
 	}
 }
 
+func (suite *DbTestSuite) TestGetDelegationsAtHeight() {
+	_ = suite.getBlock(50)
+	_ = suite.getBlock(80)
+	_ = suite.getBlock(100)
+
+	delegator := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	_, err := suite.database.Sql.Exec(`
+INSERT INTO delegation_history (validator_address, delegator_address, amount, height) 
+VALUES ($1, $2, '(cosmos,100)', 50), ($1, $2, '(cosmos,150)', 80), ($1, $2, '(cosmos,200)', 100)`,
+		validator.GetConsAddr(), delegator.String())
+	suite.Require().NoError(err)
+
+	rows, err := suite.database.GetDelegationsAtHeight(90)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equal(bddbtypes.NewDelegationRow(
+		delegator.String(),
+		validator.GetConsAddr(),
+		dbtypes.NewDbCoin(sdk.NewCoin("cosmos", sdk.NewInt(150))),
+		80,
+	)))
+
+	rows, err = suite.database.GetDelegationsAtHeight(10)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 func (suite *DbTestSuite) TestSaveRedelegations() {
@@ -27,7 +27,11 @@ WHERE staking_params.height <= excluded.height`
 	_, err := db.Sql.Exec(stmt,
 		params.BondDenom, params.UnbondingTime.Nanoseconds(), params.MaxEntries,
 		params.HistoricalEntries, params.MaxValidators, params.Height)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return db.saveProtoParamsHistory(stakingtypes.ModuleName, &params.Params, params.Height)
 }
 
 // GetStakingParams returns the types.StakingParams instance containing the current params
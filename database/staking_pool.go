@@ -1,6 +1,11 @@
 package database
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
 	"github.com/forbole/bdjuno/types"
 )
 
@@ -18,3 +23,22 @@ WHERE staking_pool.height <= excluded.height`
 	_, err := db.Sql.Exec(stmt, pool.BondedTokens.String(), pool.NotBondedTokens.String(), pool.Height)
 	return err
 }
+
+// GetStakingPool returns the most up-to-date staking pool
+func (db *Db) GetStakingPool() (*types.Pool, error) {
+	var rows []dbtypes.StakingPoolRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM staking_pool`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no staking pool found")
+	}
+
+	return types.NewPool(
+		sdk.NewInt(rows[0].BondedTokens),
+		sdk.NewInt(rows[0].NotBondedTokens),
+		rows[0].Height,
+	), nil
+}
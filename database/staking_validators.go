@@ -301,6 +301,31 @@ func (db *Db) getValidatorCommission(address sdk.ConsAddress) (*dbtypes.Validato
 	return &rows[0], true
 }
 
+// GetValidatorsCommissionRates returns the current commission rates of all the validators,
+// indexed by their consensus address
+func (db *Db) GetValidatorsCommissionRates() (map[string]sdk.Dec, error) {
+	var rows []dbtypes.ValidatorCommissionRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM validator_commission`)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates = make(map[string]sdk.Dec, len(rows))
+	for _, row := range rows {
+		if !row.Commission.Valid {
+			continue
+		}
+
+		rate, err := sdk.NewDecFromStr(row.Commission.String)
+		if err != nil {
+			return nil, err
+		}
+		rates[row.OperatorAddress] = rate
+	}
+
+	return rates, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveValidatorsVotingPowers saves the given validator voting powers.
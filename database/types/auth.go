@@ -1,8 +1,17 @@
 package types
 
+import "database/sql"
+
 // AccountRow represents a single row inside the account table
 type AccountRow struct {
-	Address string `db:"address"`
+	Address       string         `db:"address"`
+	Type          sql.NullString `db:"type"`
+	PubKey        sql.NullString `db:"pubkey"`
+	PubKeyType    sql.NullString `db:"pubkey_type"`
+	AccountNumber sql.NullInt64  `db:"account_number"`
+	Sequence      sql.NullInt64  `db:"sequence"`
+	ModuleName    sql.NullString `db:"module_name"`
+	Height        sql.NullInt64  `db:"height"`
 }
 
 // NewAccountRow allows to easily build a new AccountRow
@@ -14,7 +23,14 @@ func NewAccountRow(address string) AccountRow {
 
 // Equal tells whether a and b contain the same data
 func (a AccountRow) Equal(b AccountRow) bool {
-	return a.Address == b.Address
+	return a.Address == b.Address &&
+		a.Type == b.Type &&
+		a.PubKey == b.PubKey &&
+		a.PubKeyType == b.PubKeyType &&
+		a.AccountNumber == b.AccountNumber &&
+		a.Sequence == b.Sequence &&
+		a.ModuleName == b.ModuleName &&
+		a.Height == b.Height
 }
 
 // ________________________________________________
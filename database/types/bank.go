@@ -0,0 +1,24 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// AccountStatementRow represents a single row of the statement of an account,
+// built starting from the balance_change table
+type AccountStatementRow struct {
+	Timestamp    time.Time      `db:"timestamp"`
+	Height       int64          `db:"height"`
+	TxHash       string         `db:"transaction_hash"`
+	MessageTypes pq.StringArray `db:"message_types"`
+	Memo         string         `db:"memo"`
+	Cause        string         `db:"cause"`
+	Denom        string         `db:"denom"`
+	Amount       string         `db:"amount"`
+	Balance      sql.NullString `db:"balance"`
+	Price        sql.NullString `db:"price"`
+	FiatValue    sql.NullString `db:"fiat_value"`
+}
@@ -24,6 +24,14 @@ func ToNullString(value string) sql.NullString {
 	}
 }
 
+// ToNullDec converts the given value to a sql.NullString, which is invalid when the value is nil
+func ToNullDec(value *sdk.Dec) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+	return ToNullString(value.String())
+}
+
 // _________________________________________________________
 
 // DbCoin represents the information stored inside the database about a single coin
@@ -98,6 +106,19 @@ func (coins DbCoins) Equal(d *DbCoins) bool {
 	return true
 }
 
+// ToCoins converts this DbCoins into an sdk.Coins instance
+func (coins DbCoins) ToCoins() sdk.Coins {
+	var sdkCoins = sdk.NewCoins()
+	for _, coin := range coins {
+		amount, ok := sdk.NewIntFromString(coin.Amount)
+		if !ok {
+			continue
+		}
+		sdkCoins = sdkCoins.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return sdkCoins
+}
+
 // Scan implements sql.Scanner
 func (coins *DbCoins) Scan(src interface{}) error {
 	strValue := string(src.([]byte))
@@ -195,6 +216,19 @@ func (coins DbDecCoins) Equal(d *DbDecCoins) bool {
 	return true
 }
 
+// ToDecCoins converts this DbDecCoins into an sdk.DecCoins instance
+func (coins DbDecCoins) ToDecCoins() sdk.DecCoins {
+	var sdkCoins = sdk.NewDecCoins()
+	for _, coin := range coins {
+		amount, err := sdk.NewDecFromStr(coin.Amount)
+		if err != nil {
+			continue
+		}
+		sdkCoins = sdkCoins.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+	}
+	return sdkCoins
+}
+
 // Scan implements sql.Scanner
 func (coins *DbDecCoins) Scan(src interface{}) error {
 	strValue := string(src.([]byte))
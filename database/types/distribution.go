@@ -103,3 +103,35 @@ func (v DelegationRewardRow) Equals(w DelegationRewardRow) bool {
 		v.Amount.Equal(&w.Amount) &&
 		v.Height == w.Height
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// CommunityPoolFundRow represents a single row inside the community_pool_fund table
+type CommunityPoolFundRow struct {
+	Depositor string  `db:"depositor_address"`
+	Amount    DbCoins `db:"amount"`
+	TxHash    string  `db:"transaction_hash"`
+	MsgIndex  int64   `db:"msg_index"`
+	Height    int64   `db:"height"`
+}
+
+// CommunityPoolSpendRow represents a single row inside the community_pool_spend table
+type CommunityPoolSpendRow struct {
+	ProposalID int64   `db:"proposal_id"`
+	Recipient  string  `db:"recipient_address"`
+	Amount     DbCoins `db:"amount"`
+	Height     int64   `db:"height"`
+}
+
+// CommunityPoolFlowRow represents a single row inside the community_pool_flow view
+type CommunityPoolFlowRow struct {
+	StartHeight  int64  `db:"start_height"`
+	EndHeight    int64  `db:"end_height"`
+	Denom        string `db:"denom"`
+	StartAmount  string `db:"start_amount"`
+	EndAmount    string `db:"end_amount"`
+	Delta        string `db:"delta"`
+	Funded       string `db:"funded"`
+	Spent        string `db:"spent"`
+	OtherInflows string `db:"other_inflows"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"database/sql"
 	"time"
 )
 
@@ -147,6 +148,15 @@ func (w VoteRow) Equals(v VoteRow) bool {
 		w.Height == v.Height
 }
 
+// VoteHistoryRow represents a single row inside the proposal_vote_history table
+type VoteHistoryRow struct {
+	ProposalID int64  `db:"proposal_id"`
+	Voter      string `db:"voter_address"`
+	Option     string `db:"option"`
+	TxHash     string `db:"transaction_hash"`
+	Height     int64  `db:"height"`
+}
+
 // DepositRow represents a single row inside the deposit table
 type DepositRow struct {
 	ProposalID int64   `db:"proposal_id"`
@@ -178,6 +188,22 @@ func (w DepositRow) Equals(v DepositRow) bool {
 		w.Height == v.Height
 }
 
+// DepositHistoryRow represents a single row inside the proposal_deposit_history table
+type DepositHistoryRow struct {
+	ProposalID int64   `db:"proposal_id"`
+	Depositor  string  `db:"depositor_address"`
+	Amount     DbCoins `db:"amount"`
+	TxHash     string  `db:"transaction_hash"`
+	Height     int64   `db:"height"`
+}
+
+// DepositOutcomeRow represents a single row inside the proposal_deposit_outcome table
+type DepositOutcomeRow struct {
+	ProposalID int64  `db:"proposal_id"`
+	Outcome    string `db:"outcome"`
+	Height     int64  `db:"height"`
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 type ProposalStakingPoolSnapshotRow struct {
@@ -221,3 +247,100 @@ func NewProposalValidatorVotingPowerSnapshotRow(
 		Height:           height,
 	}
 }
+
+// ProposalStatusChangeRow represents a single row inside the proposal_status_history table
+type ProposalStatusChangeRow struct {
+	ProposalID int64     `db:"proposal_id"`
+	Status     string    `db:"status"`
+	Height     int64     `db:"height"`
+	Timestamp  time.Time `db:"timestamp"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProposalParamChangeRow represents a single row inside the proposal_param_change table
+type ProposalParamChangeRow struct {
+	ProposalID int64  `db:"proposal_id"`
+	Subspace   string `db:"subspace"`
+	Key        string `db:"key"`
+	Value      string `db:"value"`
+}
+
+// ProposalCommunityPoolSpendRow represents a single row inside the proposal_community_pool_spend table
+type ProposalCommunityPoolSpendRow struct {
+	ProposalID int64   `db:"proposal_id"`
+	Recipient  string  `db:"recipient_address"`
+	Amount     DbCoins `db:"amount"`
+}
+
+// ProposalSoftwareUpgradeRow represents a single row inside the proposal_software_upgrade table
+type ProposalSoftwareUpgradeRow struct {
+	ProposalID    int64  `db:"proposal_id"`
+	PlanName      string `db:"plan_name"`
+	UpgradeHeight int64  `db:"upgrade_height"`
+	Info          string `db:"info"`
+}
+
+// ProposalClientUpdateRow represents a single row inside the proposal_client_update table
+type ProposalClientUpdateRow struct {
+	ProposalID int64  `db:"proposal_id"`
+	ClientID   string `db:"client_id"`
+	Header     string `db:"header"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProposalValidatorTallyRow represents a single row inside the proposal_validator_tally table
+type ProposalValidatorTallyRow struct {
+	ProposalID       int64          `db:"proposal_id"`
+	ValidatorAddress string         `db:"validator_address"`
+	Option           sql.NullString `db:"option"`
+	TotalPower       string         `db:"total_power"`
+	OverriddenPower  string         `db:"overridden_power"`
+	EffectivePower   string         `db:"effective_power"`
+	Height           int64          `db:"height"`
+}
+
+// ProposalDelegatorTallyRow represents a single row inside the proposal_delegator_tally table
+type ProposalDelegatorTallyRow struct {
+	ProposalID       int64          `db:"proposal_id"`
+	DelegatorAddress string         `db:"delegator_address"`
+	ValidatorAddress string         `db:"validator_address"`
+	Power            string         `db:"power"`
+	DelegatorOption  sql.NullString `db:"delegator_option"`
+	ValidatorOption  sql.NullString `db:"validator_option"`
+	EffectiveOption  sql.NullString `db:"effective_option"`
+	Height           int64          `db:"height"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProposalTallyProjectionRow represents a single row inside the proposal_tally_projection table
+type ProposalTallyProjectionRow struct {
+	ProposalID       int64  `db:"proposal_id"`
+	Turnout          string `db:"turnout"`
+	YesRatio         string `db:"yes_ratio"`
+	VetoRatio        string `db:"veto_ratio"`
+	QuorumReached    bool   `db:"quorum_reached"`
+	ThresholdReached bool   `db:"threshold_reached"`
+	Vetoed           bool   `db:"vetoed"`
+	ProjectedOutcome string `db:"projected_outcome"`
+	Height           int64  `db:"height"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatorGovParticipationRow represents a single row of the validator_gov_participation view
+type ValidatorGovParticipationRow struct {
+	ValidatorAddress    string         `db:"validator_address"`
+	OperatorAddress     string         `db:"operator_address"`
+	SelfDelegateAddress string         `db:"self_delegate_address"`
+	ProposalsEligible   int64          `db:"proposals_eligible"`
+	ProposalsVoted      int64          `db:"proposals_voted"`
+	ParticipationRate   float64        `db:"participation_rate"`
+	YesVotes            int64          `db:"yes_votes"`
+	NoVotes             int64          `db:"no_votes"`
+	AbstainVotes        int64          `db:"abstain_votes"`
+	NoWithVetoVotes     int64          `db:"no_with_veto_votes"`
+	AverageTimeToVote   sql.NullString `db:"average_time_to_vote"`
+}
@@ -0,0 +1,12 @@
+package types
+
+import "database/sql"
+
+// ParamsHistoryRow represents a single row inside the params_history table
+type ParamsHistoryRow struct {
+	Module     string        `db:"module"`
+	Params     string        `db:"params"`
+	Diff       string        `db:"diff"`
+	ProposalID sql.NullInt64 `db:"proposal_id"`
+	Height     int64         `db:"height"`
+}
@@ -0,0 +1,40 @@
+package types
+
+import "time"
+
+// ProfileRow represents a single row inside the profile table
+type ProfileRow struct {
+	Address      string    `db:"address"`
+	DTag         string    `db:"dtag"`
+	Nickname     string    `db:"nickname"`
+	Bio          string    `db:"bio"`
+	ProfilePic   string    `db:"profile_pic"`
+	CoverPic     string    `db:"cover_pic"`
+	CreationTime time.Time `db:"creation_time"`
+	Height       int64     `db:"height"`
+}
+
+// DTagTransferRequestRow represents a single row inside the dtag_transfer_request table
+type DTagTransferRequestRow struct {
+	DTagToTrade string `db:"dtag_to_trade"`
+	Sender      string `db:"sender"`
+	Receiver    string `db:"receiver"`
+	Height      int64  `db:"height"`
+}
+
+// RelationshipRow represents a single row inside the profile_relationship table
+type RelationshipRow struct {
+	Creator   string `db:"creator"`
+	Recipient string `db:"recipient"`
+	Subspace  string `db:"subspace"`
+	Height    int64  `db:"height"`
+}
+
+// UserBlockRow represents a single row inside the user_block table
+type UserBlockRow struct {
+	Blocker  string `db:"blocker"`
+	Blocked  string `db:"blocked"`
+	Reason   string `db:"reason"`
+	Subspace string `db:"subspace"`
+	Height   int64  `db:"height"`
+}
@@ -0,0 +1,13 @@
+package types
+
+import "database/sql"
+
+// UpgradePlanRow represents a single row inside the upgrade_plan table
+type UpgradePlanRow struct {
+	OneRowID      bool          `db:"one_row_id"`
+	Name          string        `db:"name"`
+	UpgradeHeight int64         `db:"upgrade_height"`
+	Info          string        `db:"info"`
+	ProposalID    sql.NullInt64 `db:"proposal_id"`
+	Height        int64         `db:"height"`
+}
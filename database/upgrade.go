@@ -0,0 +1,99 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types"
+)
+
+// SaveUpgradePlan allows to store the given upgrade plan, replacing any existing older one.
+// When the proposal id is not known, the passed proposal that has scheduled the plan is used, if any
+func (db *Db) SaveUpgradePlan(plan types.UpgradePlan) error {
+	stmt := `
+INSERT INTO upgrade_plan (name, upgrade_height, info, proposal_id, height) 
+VALUES ($1, $2, $3, COALESCE($4, (
+	SELECT id FROM proposal WHERE content -> 'plan' ->> 'name' = $1 AND status = $5 ORDER BY id DESC LIMIT 1
+)), $6)
+ON CONFLICT (one_row_id) DO UPDATE 
+	SET name = excluded.name,
+	    upgrade_height = excluded.upgrade_height,
+	    info = excluded.info,
+	    proposal_id = excluded.proposal_id,
+	    height = excluded.height
+WHERE upgrade_plan.height <= excluded.height`
+	_, err := db.Sql.Exec(stmt,
+		plan.Name, plan.UpgradeHeight, plan.Info, toNullProposalID(plan.ProposalID),
+		govtypes.StatusPassed.String(), plan.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing upgrade plan: %s", err)
+	}
+
+	return nil
+}
+
+// GetUpgradePlan returns the upgrade plan that is currently stored, or nil if there is none
+func (db *Db) GetUpgradePlan() (*types.UpgradePlan, error) {
+	var rows []dbtypes.UpgradePlanRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM upgrade_plan`)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	return &types.UpgradePlan{
+		Name:          row.Name,
+		UpgradeHeight: row.UpgradeHeight,
+		Info:          row.Info,
+		ProposalID:    uint64(row.ProposalID.Int64),
+		Height:        row.Height,
+	}, nil
+}
+
+// DeleteUpgradePlan removes the stored upgrade plan if it is not newer than the given height
+func (db *Db) DeleteUpgradePlan(height int64) error {
+	_, err := db.Sql.Exec(`DELETE FROM upgrade_plan WHERE height <= $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting upgrade plan: %s", err)
+	}
+
+	return nil
+}
+
+// SaveAppliedUpgrade allows to store the given applied upgrade, removing the plan that has been applied
+func (db *Db) SaveAppliedUpgrade(upgrade types.AppliedUpgrade) error {
+	stmt := `
+INSERT INTO applied_upgrade (name, info, proposal_id, height) 
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (name) DO UPDATE 
+	SET info = excluded.info,
+	    proposal_id = COALESCE(excluded.proposal_id, applied_upgrade.proposal_id),
+	    height = excluded.height`
+	_, err := db.Sql.Exec(stmt, upgrade.Name, upgrade.Info, toNullProposalID(upgrade.ProposalID), upgrade.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing applied upgrade: %s", err)
+	}
+
+	_, err = db.Sql.Exec(`DELETE FROM upgrade_plan WHERE name = $1`, upgrade.Name)
+	if err != nil {
+		return fmt.Errorf("error while deleting applied upgrade plan: %s", err)
+	}
+
+	return nil
+}
+
+// toNullProposalID converts the given proposal id to a sql.NullInt64, which is invalid when the id is zero
+func toNullProposalID(id uint64) sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: int64(id),
+		Valid: id != 0,
+	}
+}
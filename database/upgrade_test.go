@@ -0,0 +1,73 @@
+package database_test
+
+import (
+	"time"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/forbole/bdjuno/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveUpgradePlan() {
+	proposer := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	plan := upgradetypes.Plan{Name: "v2.0.0", Height: 100, Info: "binaries"}
+	err := suite.database.SaveProposals([]types.Proposal{
+		types.NewProposal(
+			1,
+			upgradetypes.RouterKey,
+			upgradetypes.ProposalTypeSoftwareUpgrade,
+			upgradetypes.NewSoftwareUpgradeProposal("title", "description", plan),
+			govtypes.StatusPassed.String(),
+			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+			time.Date(2020, 1, 1, 01, 00, 00, 000, time.UTC),
+			time.Date(2020, 1, 1, 02, 00, 00, 000, time.UTC),
+			time.Date(2020, 1, 1, 03, 00, 00, 000, time.UTC),
+			proposer.String(),
+		),
+	})
+	suite.Require().NoError(err)
+
+	// Save the plan without knowing its proposal
+	err = suite.database.SaveUpgradePlan(types.NewUpgradePlan(plan, 0, 10))
+	suite.Require().NoError(err)
+
+	stored, err := suite.database.GetUpgradePlan()
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.UpgradePlan{
+		Name:          "v2.0.0",
+		UpgradeHeight: 100,
+		Info:          "binaries",
+		ProposalID:    1,
+		Height:        10,
+	}, stored)
+
+	// Make sure an older plan is not deleted
+	err = suite.database.DeleteUpgradePlan(9)
+	suite.Require().NoError(err)
+
+	stored, err = suite.database.GetUpgradePlan()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(stored)
+
+	// Apply the plan
+	err = suite.database.SaveAppliedUpgrade(types.NewAppliedUpgrade(stored.Name, stored.Info, stored.ProposalID, 100))
+	suite.Require().NoError(err)
+
+	stored, err = suite.database.GetUpgradePlan()
+	suite.Require().NoError(err)
+	suite.Require().Nil(stored)
+
+	var rows []struct {
+		Name       string `db:"name"`
+		ProposalID int64  `db:"proposal_id"`
+		Height     int64  `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `SELECT name, proposal_id, height FROM applied_upgrade`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("v2.0.0", rows[0].Name)
+	suite.Require().Equal(int64(1), rows[0].ProposalID)
+	suite.Require().Equal(int64(100), rows[0].Height)
+}
@@ -0,0 +1,21 @@
+package utils
+
+import "github.com/forbole/bdjuno/types"
+
+func SplitProposalDelegatorTallies(
+	tallies []types.ProposalDelegatorTally, paramsNumber int,
+) [][]types.ProposalDelegatorTally {
+	maxTalliesPerSlice := maxPostgreSQLParams / paramsNumber
+	slices := make([][]types.ProposalDelegatorTally, len(tallies)/maxTalliesPerSlice+1)
+
+	sliceIndex := 0
+	for index, tally := range tallies {
+		slices[sliceIndex] = append(slices[sliceIndex], tally)
+
+		if index > 0 && index%(maxTalliesPerSlice-1) == 0 {
+			sliceIndex++
+		}
+	}
+
+	return slices
+}
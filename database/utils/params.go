@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ParamChange contains the old and new values of a single parameter
+type ParamChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// ParamsDiff contains the changes between two sets of params, indexed by the path of each parameter.
+// Nested parameters have their path made of the single keys joined by a dot (eg. "deposit_params.min_deposit")
+type ParamsDiff map[string]ParamChange
+
+// GetParamsDiff returns the differences between the given JSON-encoded params.
+// When oldParams is nil, all the new params are considered as changed
+func GetParamsDiff(oldParams, newParams json.RawMessage) (ParamsDiff, error) {
+	oldValues := map[string]interface{}{}
+	if oldParams != nil {
+		var value interface{}
+		if err := json.Unmarshal(oldParams, &value); err != nil {
+			return nil, err
+		}
+		flattenParams("", value, oldValues)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(newParams, &value); err != nil {
+		return nil, err
+	}
+	newValues := map[string]interface{}{}
+	flattenParams("", value, newValues)
+
+	diff := ParamsDiff{}
+	for key, newValue := range newValues {
+		oldValue, found := oldValues[key]
+		if !found || !reflect.DeepEqual(oldValue, newValue) {
+			diff[key] = ParamChange{Old: oldValue, New: newValue}
+		}
+	}
+
+	for key, oldValue := range oldValues {
+		if _, found := newValues[key]; !found {
+			diff[key] = ParamChange{Old: oldValue, New: nil}
+		}
+	}
+
+	return diff, nil
+}
+
+// flattenParams puts inside values all the leaf values of the given params, indexed by their path
+func flattenParams(path string, params interface{}, values map[string]interface{}) {
+	object, ok := params.(map[string]interface{})
+	if !ok || len(object) == 0 {
+		values[path] = params
+		return
+	}
+
+	for key, value := range object {
+		if path != "" {
+			key = path + "." + key
+		}
+		flattenParams(key, value, values)
+	}
+}
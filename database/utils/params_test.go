@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/database/utils"
+)
+
+func TestGetParamsDiff(t *testing.T) {
+	testCases := []struct {
+		name      string
+		oldParams json.RawMessage
+		newParams json.RawMessage
+		expected  utils.ParamsDiff
+	}{
+		{
+			name:      "nil old params return all the new ones",
+			oldParams: nil,
+			newParams: json.RawMessage(`{"unbonding_time":"1814400s","max_validators":100}`),
+			expected: utils.ParamsDiff{
+				"unbonding_time": {Old: nil, New: "1814400s"},
+				"max_validators": {Old: nil, New: float64(100)},
+			},
+		},
+		{
+			name:      "equal params return an empty diff",
+			oldParams: json.RawMessage(`{"unbonding_time":"1814400s","max_validators":100}`),
+			newParams: json.RawMessage(`{"max_validators":100,"unbonding_time":"1814400s"}`),
+			expected:  utils.ParamsDiff{},
+		},
+		{
+			name:      "changed params are returned",
+			oldParams: json.RawMessage(`{"unbonding_time":"1814400s","max_validators":100}`),
+			newParams: json.RawMessage(`{"unbonding_time":"1209600s","max_validators":100}`),
+			expected: utils.ParamsDiff{
+				"unbonding_time": {Old: "1814400s", New: "1209600s"},
+			},
+		},
+		{
+			name:      "nested params use the dotted path",
+			oldParams: json.RawMessage(`{"voting_params":{"voting_period":"172800s"},"tally_params":{"quorum":"0.4"}}`),
+			newParams: json.RawMessage(`{"voting_params":{"voting_period":"86400s"},"tally_params":{"quorum":"0.4"}}`),
+			expected: utils.ParamsDiff{
+				"voting_params.voting_period": {Old: "172800s", New: "86400s"},
+			},
+		},
+		{
+			name:      "removed and added params are returned",
+			oldParams: json.RawMessage(`{"first":"1"}`),
+			newParams: json.RawMessage(`{"second":"2"}`),
+			expected: utils.ParamsDiff{
+				"first":  {Old: "1", New: nil},
+				"second": {Old: nil, New: "2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := utils.GetParamsDiff(tc.oldParams, tc.newParams)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, diff)
+		})
+	}
+}
@@ -8,13 +8,16 @@ import (
 	"github.com/forbole/bdjuno/database"
 	"github.com/forbole/bdjuno/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
 // FastSync downloads the x/auth state at the given height, and stores it inside the database
-func FastSync(height int64, client authttypes.QueryClient, db *database.Db) error {
-	err := updateAccounts(height, client, db)
+func FastSync(height int64, client authttypes.QueryClient, cdc codec.Marshaler, db *database.Db) error {
+	err := updateAccounts(height, client, cdc, db)
 	if err != nil {
 		return err
 	}
@@ -23,7 +26,7 @@ func FastSync(height int64, client authttypes.QueryClient, db *database.Db) erro
 }
 
 // updateAccounts downloads all the accounts at the given height, and stores them inside the database
-func updateAccounts(height int64, authClient authttypes.QueryClient, db *database.Db) error {
+func updateAccounts(height int64, authClient authttypes.QueryClient, cdc codec.Marshaler, db *database.Db) error {
 	header := client.GetHeightRequestHeader(height)
 
 	var nextKey []byte
@@ -44,10 +47,26 @@ func updateAccounts(height int64, authClient authttypes.QueryClient, db *databas
 		}
 
 		var accounts = make([]types.Account, len(res.Accounts))
+		var details = make([]types.AccountDetails, len(res.Accounts))
+		var vestingAccounts []exported.VestingAccount
+		var multisigAccounts []types.MultisigAccount
 		for index, acc := range res.Accounts {
-			accounts[index] = types.NewAccount(
-				acc.GetCachedValue().(authttypes.AccountI).GetAddress().String(),
-			)
+			var account authttypes.AccountI
+			err = cdc.UnpackAny(acc, &account)
+			if err != nil {
+				return err
+			}
+
+			accounts[index] = types.NewAccount(account.GetAddress().String())
+			details[index] = types.NewAccountDetails(account, height)
+
+			if vestingAccount, ok := account.(exported.VestingAccount); ok {
+				vestingAccounts = append(vestingAccounts, vestingAccount)
+			}
+
+			if multisigPubKey, ok := account.GetPubKey().(*multisig.LegacyAminoPubKey); ok {
+				multisigAccounts = append(multisigAccounts, types.NewMultisigAccount(multisigPubKey, height))
+			}
 		}
 
 		err = db.SaveAccounts(accounts)
@@ -55,6 +74,23 @@ func updateAccounts(height int64, authClient authttypes.QueryClient, db *databas
 			return err
 		}
 
+		err = db.SaveAccountsDetails(details)
+		if err != nil {
+			return err
+		}
+
+		err = db.SaveVestingAccounts(vestingAccounts)
+		if err != nil {
+			return err
+		}
+
+		for _, multisigAccount := range multisigAccounts {
+			err = db.SaveMultisigAccount(multisigAccount)
+			if err != nil {
+				return err
+			}
+		}
+
 		nextKey = res.Pagination.NextKey
 		stop = len(res.Pagination.NextKey) == 0
 	}
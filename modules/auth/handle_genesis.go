@@ -9,13 +9,16 @@ import (
 	"github.com/forbole/bdjuno/database"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	authutils "github.com/forbole/bdjuno/modules/auth/utils"
 )
 
 // Handler handles the genesis state of the x/auth module in order to store the initial values
 // of the different accounts.
-func Handler(appState map[string]json.RawMessage, cdc codec.Marshaler, db *database.Db) error {
+func Handler(
+	doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage, cdc codec.Marshaler, db *database.Db,
+) error {
 	log.Debug().Str("module", "auth").Msg("parsing genesis")
 
 	accounts, err := authutils.GetGenesisAccounts(appState, cdc)
@@ -28,5 +31,25 @@ func Handler(appState map[string]json.RawMessage, cdc codec.Marshaler, db *datab
 		return fmt.Errorf("error while storing genesis accounts: %s", err)
 	}
 
+	details, err := authutils.GetGenesisAccountsDetails(appState, doc.InitialHeight, cdc)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveAccountsDetails(details)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis accounts details: %s", err)
+	}
+
+	vestingAccounts, err := authutils.GetGenesisVestingAccounts(appState, cdc)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveVestingAccounts(vestingAccounts)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis vesting accounts: %s", err)
+	}
+
 	return nil
 }
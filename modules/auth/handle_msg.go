@@ -1,18 +1,27 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/desmos-labs/juno/modules/messages"
+	juno "github.com/desmos-labs/juno/types"
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/bdjuno/database"
 	authutils "github.com/forbole/bdjuno/modules/auth/utils"
 	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types"
 )
 
 // HandleMsg handles any message updating the involved accounts
-func HandleMsg(msg sdk.Msg, getAddresses messages.MessageAddressesParser, cdc codec.Marshaler, db *database.Db) error {
+func HandleMsg(
+	tx *juno.Tx, msg sdk.Msg, getAddresses messages.MessageAddressesParser, cdc codec.Marshaler, db *database.Db,
+) error {
 	addresses, err := getAddresses(cdc, msg)
 	if err != nil {
 		log.Error().Str("module", "auth").Err(err).
@@ -20,5 +29,47 @@ func HandleMsg(msg sdk.Msg, getAddresses messages.MessageAddressesParser, cdc co
 			Msgf("error while refreshing accounts after message of type %s", msg.Type())
 	}
 
-	return authutils.UpdateAccounts(utils.FilterNonAccountAddresses(addresses), db)
+	err = authutils.UpdateAccounts(utils.FilterNonAccountAddresses(addresses), db)
+	if err != nil {
+		return err
+	}
+
+	if createVestingMsg, ok := msg.(*vestingtypes.MsgCreateVestingAccount); ok {
+		return handleMsgCreateVestingAccount(tx, createVestingMsg, db)
+	}
+
+	return nil
+}
+
+// handleMsgCreateVestingAccount stores the vesting account created by the given message
+func handleMsgCreateVestingAccount(tx *juno.Tx, msg *vestingtypes.MsgCreateVestingAccount, db *database.Db) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	baseAccount := authttypes.NewBaseAccountWithAddress(address)
+
+	var account exported.VestingAccount
+	if msg.Delayed {
+		account = vestingtypes.NewDelayedVestingAccount(baseAccount, msg.Amount, msg.EndTime)
+	} else {
+		account = vestingtypes.NewContinuousVestingAccount(baseAccount, msg.Amount, timestamp.Unix(), msg.EndTime)
+	}
+
+	err = db.SaveAccounts([]types.Account{types.NewAccount(msg.ToAddress)})
+	if err != nil {
+		return err
+	}
+
+	return db.SaveVestingAccounts([]exported.VestingAccount{account})
 }
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	authutils "github.com/forbole/bdjuno/modules/auth/utils"
+	"github.com/forbole/bdjuno/modules/utils"
+)
+
+// RegisterPeriodicOps registers the additional utils that periodically run
+func RegisterPeriodicOps(
+	scheduler *gocron.Scheduler, authClient authttypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "auth").Msg("setting up periodic tasks")
+
+	// Update the vesting balances every 1 hour
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return updateVestingBalances(authClient, cdc, db) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateVestingBalances updates the vested, unvested and delegated amounts of all the vesting accounts
+func updateVestingBalances(authClient authttypes.QueryClient, cdc codec.Marshaler, db *database.Db) error {
+	block, err := db.GetLastBlock()
+	if err != nil {
+		return err
+	}
+
+	addresses, err := db.GetVestingAccountsAddresses()
+	if err != nil {
+		return err
+	}
+
+	return authutils.UpdateVestingBalances(addresses, block.Height, block.Timestamp, authClient, cdc, db)
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// HandleTx updates the public keys and sequences of the accounts that have signed the given transaction
+func HandleTx(tx *juno.Tx, cdc codec.Marshaler, db *database.Db) error {
+	var signatures []types.AccountSignature
+	var multisigSignatures []types.MultisigSignature
+	var multisigAccounts []types.MultisigAccount
+
+	// The signer infos are sorted in the same order as the signers of the transaction,
+	// which allows to know who signed even when the public key is not included
+	signers := tx.GetSigners()
+
+	for index, signerInfo := range tx.AuthInfo.SignerInfos {
+		var pubKey cryptotypes.PubKey
+		if signerInfo.PublicKey != nil {
+			err := cdc.UnpackAny(signerInfo.PublicKey, &pubKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		address, err := getSignerAddress(signers, index, pubKey)
+		if err != nil {
+			return err
+		}
+
+		// The sequence inside the signer info is the one used to sign, so the account one has been increased
+		signatures = append(signatures, types.NewAccountSignature(address, pubKey, signerInfo.Sequence+1, tx.Height))
+
+		if multisigPubKey, ok := pubKey.(*multisig.LegacyAminoPubKey); ok {
+			account := types.NewMultisigAccount(multisigPubKey, tx.Height)
+			multisigAccounts = append(multisigAccounts, account)
+			multisigSignatures = append(multisigSignatures, types.NewMultisigSignature(
+				tx.TxHash,
+				address,
+				getMultisigSigners(account, signerInfo.ModeInfo),
+				tx.Height,
+			))
+		}
+	}
+
+	err := db.SaveAccountsSignatures(signatures)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range multisigAccounts {
+		err = db.SaveMultisigAccount(account)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, signature := range multisigSignatures {
+		err = db.SaveMultisigSignature(signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getSignerAddress returns the address of the signer having the given index.
+// If the index is not found among the transaction signers, the address is derived from the given public key
+func getSignerAddress(signers []sdk.AccAddress, index int, pubKey cryptotypes.PubKey) (string, error) {
+	if index < len(signers) {
+		return signers[index].String(), nil
+	}
+
+	if pubKey == nil {
+		return "", fmt.Errorf("error while getting the address of signer %d: signer not found", index)
+	}
+
+	return sdk.AccAddress(pubKey.Address()).String(), nil
+}
+
+// getMultisigSigners returns the addresses of the members of the given multisig account
+// that have actually signed, based on the bit array contained inside the given mode info
+func getMultisigSigners(account types.MultisigAccount, modeInfo *tx.ModeInfo) []string {
+	multi := modeInfo.GetMulti()
+	if multi == nil || multi.Bitarray == nil {
+		return nil
+	}
+
+	var signers []string
+	for index, member := range account.Members {
+		if multi.Bitarray.GetIndex(index) {
+			signers = append(signers, member)
+		}
+	}
+	return signers
+}
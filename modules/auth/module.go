@@ -11,14 +11,17 @@ import (
 	"github.com/desmos-labs/juno/modules"
 	"github.com/desmos-labs/juno/modules/messages"
 	juno "github.com/desmos-labs/juno/types"
+	"github.com/go-co-op/gocron"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 var (
-	_ modules.Module         = &Module{}
-	_ modules.GenesisModule  = &Module{}
-	_ modules.MessageModule  = &Module{}
-	_ modules.FastSyncModule = &Module{}
+	_ modules.Module                   = &Module{}
+	_ modules.GenesisModule            = &Module{}
+	_ modules.TransactionModule        = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.FastSyncModule           = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
 )
 
 // Module represents the x/auth module
@@ -50,15 +53,25 @@ func (m *Module) Name() string {
 
 // DownloadState implements modules.FastSyncModule
 func (m *Module) DownloadState(height int64) error {
-	return FastSync(height, m.authClient, m.db)
+	return FastSync(height, m.authClient, m.encodingConfig.Marshaler, m.db)
 }
 
 // HandleGenesis implements modules.GenesisModule
-func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
-	return Handler(appState, m.encodingConfig.Marshaler, m.db)
+func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+	return Handler(doc, appState, m.encodingConfig.Marshaler, m.db)
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	return HandleTx(tx, m.encodingConfig.Marshaler, m.db)
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, _ *juno.Tx) error {
-	return HandleMsg(msg, m.messagesParser, m.encodingConfig.Marshaler, m.db)
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+	return HandleMsg(tx, msg, m.messagesParser, m.encodingConfig.Marshaler, m.db)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return RegisterPeriodicOps(scheduler, m.authClient, m.encodingConfig.Marshaler, m.db)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/bdjuno/database"
@@ -34,6 +35,54 @@ func GetGenesisAccounts(appState map[string]json.RawMessage, cdc codec.Marshaler
 	return accounts, nil
 }
 
+// GetGenesisAccountsDetails parses the given appState and returns the details of the genesis accounts
+func GetGenesisAccountsDetails(
+	appState map[string]json.RawMessage, height int64, cdc codec.Marshaler,
+) ([]types.AccountDetails, error) {
+	var authState authttypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authttypes.ModuleName], &authState); err != nil {
+		return nil, err
+	}
+
+	details := make([]types.AccountDetails, len(authState.Accounts))
+	for index, account := range authState.Accounts {
+		var accountI authttypes.AccountI
+		err := cdc.UnpackAny(account, &accountI)
+		if err != nil {
+			return nil, err
+		}
+
+		details[index] = types.NewAccountDetails(accountI, height)
+	}
+
+	return details, nil
+}
+
+// GetGenesisVestingAccounts parses the given appState and returns the genesis vesting accounts
+func GetGenesisVestingAccounts(
+	appState map[string]json.RawMessage, cdc codec.Marshaler,
+) ([]exported.VestingAccount, error) {
+	var authState authttypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authttypes.ModuleName], &authState); err != nil {
+		return nil, err
+	}
+
+	var vestingAccounts []exported.VestingAccount
+	for _, account := range authState.Accounts {
+		var accountI authttypes.AccountI
+		err := cdc.UnpackAny(account, &accountI)
+		if err != nil {
+			return nil, err
+		}
+
+		if vestingAccount, ok := accountI.(exported.VestingAccount); ok {
+			vestingAccounts = append(vestingAccounts, vestingAccount)
+		}
+	}
+
+	return vestingAccounts, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // GetAccounts returns the account data for the given addresses
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/desmos-labs/juno/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// UpdateVestingBalances queries the vesting accounts having the given addresses at the given height,
+// and stores their vested, unvested and delegated amounts computed at the given block time
+func UpdateVestingBalances(
+	addresses []string, height int64, blockTime time.Time,
+	authClient authttypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "auth").Int64("height", height).Msg("updating vesting balances")
+	header := client.GetHeightRequestHeader(height)
+
+	var balances []types.VestingBalance
+	for _, address := range addresses {
+		res, err := authClient.Account(
+			context.Background(),
+			&authttypes.QueryAccountRequest{Address: address},
+			header,
+		)
+		if err != nil {
+			return err
+		}
+
+		var account authttypes.AccountI
+		err = cdc.UnpackAny(res.Account, &account)
+		if err != nil {
+			return err
+		}
+
+		vestingAccount, ok := account.(exported.VestingAccount)
+		if !ok {
+			continue
+		}
+
+		balances = append(balances, types.NewVestingBalance(
+			address,
+			vestingAccount.GetVestedCoins(blockTime),
+			vestingAccount.GetVestingCoins(blockTime),
+			vestingAccount.GetDelegatedVesting(),
+			vestingAccount.GetDelegatedFree(),
+			height,
+		))
+	}
+
+	return db.SaveVestingBalances(balances)
+}
@@ -3,24 +3,41 @@ package bank
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/desmos-labs/juno/client"
+	juno "github.com/desmos-labs/juno/types"
 
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/bdjuno/database"
+	authutils "github.com/forbole/bdjuno/modules/auth/utils"
+	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
+	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 // HandleBlock allows to handle a block properly
-func HandleBlock(block *tmctypes.ResultBlock, bankClient banktypes.QueryClient, db *database.Db) error {
+func HandleBlock(
+	block *tmctypes.ResultBlock, txs []*juno.Tx, blockResults *utils.BlockResultsCache,
+	bankClient banktypes.QueryClient, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
 	err := updateSupply(block.Block.Height, bankClient, db)
 	if err != nil {
 		log.Error().Str("module", "bank").Int64("height", block.Block.Height).
 			Err(err).Msg("error while updating supply")
 	}
 
+	err = handleBlockEvents(block.Block.Height, txs, blockResults, bankClient, stakingClient, cdc, db)
+	if err != nil {
+		log.Error().Str("module", "bank").Int64("height", block.Block.Height).
+			Err(err).Msg("error while handling block events")
+	}
+
 	return nil
 }
 
@@ -40,3 +57,79 @@ func updateSupply(height int64, bankClient banktypes.QueryClient, db *database.D
 
 	return db.SaveSupply(res.Supply, height)
 }
+
+// handleBlockEvents stores the balance changes that happened during the BeginBlock and EndBlock
+// of the given height (eg. module payouts, unbonding completions and slashes), updating the involved balances
+func handleBlockEvents(
+	height int64, txs []*juno.Tx, blockResults *utils.BlockResultsCache,
+	bankClient banktypes.QueryClient, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "bank").Int64("height", height).
+		Msg("handling block events")
+
+	res, err := blockResults.GetBlockResults(height)
+	if err != nil {
+		return err
+	}
+
+	events := sdk.StringifyEvents(append(res.BeginBlockEvents, res.EndBlockEvents...))
+
+	changes := bankutils.GetBlockBalanceChanges(height, events)
+	addresses := bankutils.GetBalanceChangesAddresses(events)
+
+	if bankutils.HasSlashEvents(events, txs) {
+		slashChanges, err := getSlashBalanceChanges(height, txs, changes, bankClient, stakingClient, cdc)
+		if err != nil {
+			return err
+		}
+
+		changes = append(changes, slashChanges...)
+		for _, change := range slashChanges {
+			addresses = bankutils.MergeAddresses(addresses, []string{change.Address})
+		}
+	}
+
+	err = db.SaveBalanceChanges(changes)
+	if err != nil {
+		return err
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	err = authutils.UpdateAccounts(addresses, db)
+	if err != nil {
+		return err
+	}
+
+	return bankutils.UpdateBalances(addresses, height, bankClient, db)
+}
+
+// getSlashBalanceChanges returns the balance changes caused by the slashes that happened at the given height,
+// computed from the changes of the staking pools balances and the other changes caused by the block and its transactions
+func getSlashBalanceChanges(
+	height int64, txs []*juno.Tx, blockChanges []types.BalanceChange,
+	bankClient banktypes.QueryClient, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler,
+) ([]types.BalanceChange, error) {
+	recorded := blockChanges
+	for _, tx := range txs {
+		txChanges, err := bankutils.GetTxBalanceChanges(tx, cdc, getValidatorBondedResolver(height, stakingClient))
+		if err != nil {
+			return nil, err
+		}
+		recorded = append(recorded, txChanges...)
+	}
+
+	previousBalances, err := bankutils.GetStakingPoolsBalances(height-1, bankClient)
+	if err != nil {
+		return nil, err
+	}
+
+	currentBalances, err := bankutils.GetStakingPoolsBalances(height, bankClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return bankutils.GetSlashBalanceChanges(height, previousBalances, currentBalances, recorded), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/bdjuno/database"
+	authutils "github.com/forbole/bdjuno/modules/auth/utils"
 	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
 	"github.com/forbole/bdjuno/modules/utils"
 
@@ -14,9 +15,10 @@ import (
 	juno "github.com/desmos-labs/juno/types"
 )
 
-// HandleMsg handles any message updating the involved addresses balances
+// HandleMsg handles any message updating the balances of the involved addresses
+// and of the ones whose balance has been changed based on the message events
 func HandleMsg(
-	tx *juno.Tx, msg sdk.Msg, getAddresses messages.MessageAddressesParser, bankClient banktypes.QueryClient,
+	tx *juno.Tx, index int, msg sdk.Msg, getAddresses messages.MessageAddressesParser, bankClient banktypes.QueryClient,
 	cdc codec.Marshaler, db *database.Db,
 ) error {
 	addresses, err := getAddresses(cdc, msg)
@@ -25,5 +27,16 @@ func HandleMsg(
 			Err(err).Msgf("error while refreshing balances after message of type %s", msg.Type())
 	}
 
-	return bankutils.UpdateBalances(utils.FilterNonAccountAddresses(addresses), tx.Height, bankClient, db)
+	addresses = utils.FilterNonAccountAddresses(addresses)
+	if index < len(tx.Logs) {
+		addresses = bankutils.MergeAddresses(addresses, bankutils.GetBalanceChangesAddresses(tx.Logs[index].Events))
+	}
+
+	// Make sure all the accounts exist, as some of them might be present only inside the events
+	err = authutils.UpdateAccounts(addresses, db)
+	if err != nil {
+		return err
+	}
+
+	return bankutils.UpdateBalances(addresses, tx.Height, bankClient, db)
 }
@@ -0,0 +1,50 @@
+package bank
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
+	"github.com/forbole/bdjuno/modules/utils"
+)
+
+// RegisterPeriodicOps registers the additional utils that periodically run
+func RegisterPeriodicOps(
+	scheduler *gocron.Scheduler, excludedAddresses []string,
+	authClient authttypes.QueryClient, bankClient banktypes.QueryClient, distrClient distrtypes.QueryClient,
+	cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "bank").Msg("setting up periodic tasks")
+
+	// Update the circulating supply every 1 hour
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error {
+			return updateCirculatingSupply(excludedAddresses, authClient, bankClient, distrClient, cdc, db)
+		})
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateCirculatingSupply computes the circulating supply at the latest height and stores it inside the database
+func updateCirculatingSupply(
+	excludedAddresses []string,
+	authClient authttypes.QueryClient, bankClient banktypes.QueryClient, distrClient distrtypes.QueryClient,
+	cdc codec.Marshaler, db *database.Db,
+) error {
+	block, err := db.GetLastBlock()
+	if err != nil {
+		return err
+	}
+
+	return bankutils.UpdateCirculatingSupply(
+		block.Height, block.Timestamp, excludedAddresses, authClient, bankClient, distrClient, cdc, db,
+	)
+}
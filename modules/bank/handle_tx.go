@@ -0,0 +1,49 @@
+package bank
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/desmos-labs/juno/client"
+	juno "github.com/desmos-labs/juno/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/forbole/bdjuno/database"
+	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
+)
+
+// HandleTx stores all the balance changes caused by the given transaction
+func HandleTx(tx *juno.Tx, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler, db *database.Db) error {
+	changes, err := bankutils.GetTxBalanceChanges(tx, cdc, getValidatorBondedResolver(tx.Height, stakingClient))
+	if err != nil {
+		return err
+	}
+
+	return db.SaveBalanceChanges(changes)
+}
+
+// getValidatorBondedResolver returns a resolver that tells whether a validator was bonded while the transactions
+// of the given height were executed. Validator statuses only change during the EndBlock,
+// so the ones at the previous height are used
+func getValidatorBondedResolver(height int64, stakingClient stakingtypes.QueryClient) bankutils.ValidatorBondedResolver {
+	header := client.GetHeightRequestHeader(height - 1)
+	return func(operatorAddress string) (bool, error) {
+		res, err := stakingClient.Validator(
+			context.Background(),
+			&stakingtypes.QueryValidatorRequest{ValidatorAddr: operatorAddress},
+			header,
+		)
+		if status.Code(err) == codes.NotFound {
+			// The validator has been created inside the same block, so it is not bonded yet
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return res.Validator.IsBonded(), nil
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types/config"
 
 	junomessages "github.com/desmos-labs/juno/modules/messages"
 
@@ -11,39 +13,53 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/desmos-labs/juno/modules"
 	"github.com/desmos-labs/juno/types"
+	"github.com/go-co-op/gocron"
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 var (
-	_ modules.Module        = &Module{}
-	_ modules.GenesisModule = &Module{}
-	_ modules.BlockModule   = &Module{}
-	_ modules.MessageModule = &Module{}
+	_ modules.Module                   = &Module{}
+	_ modules.GenesisModule            = &Module{}
+	_ modules.BlockModule              = &Module{}
+	_ modules.TransactionModule        = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
 )
 
 // Module represents the x/bank module
 type Module struct {
 	messageParser  junomessages.MessageAddressesParser
 	encodingConfig *params.EncodingConfig
+	blockResults   *utils.BlockResultsCache
 	authClient     authttypes.QueryClient
 	bankClient     banktypes.QueryClient
+	distrClient    distrtypes.QueryClient
+	stakingClient  stakingtypes.QueryClient
+	supplyConfig   *config.SupplyConfig
 	db             *database.Db
 }
 
 // NewModule returns a new Module instance
 func NewModule(
-	messageParser junomessages.MessageAddressesParser,
-	authClient authttypes.QueryClient, bankClient banktypes.QueryClient,
-	encodingConfig *params.EncodingConfig, db *database.Db,
+	messageParser junomessages.MessageAddressesParser, blockResults *utils.BlockResultsCache,
+	authClient authttypes.QueryClient, bankClient banktypes.QueryClient, distrClient distrtypes.QueryClient,
+	stakingClient stakingtypes.QueryClient,
+	supplyConfig *config.SupplyConfig, encodingConfig *params.EncodingConfig, db *database.Db,
 ) *Module {
 	return &Module{
 		messageParser:  messageParser,
 		encodingConfig: encodingConfig,
+		blockResults:   blockResults,
 		authClient:     authClient,
 		bankClient:     bankClient,
+		distrClient:    distrClient,
+		stakingClient:  stakingClient,
+		supplyConfig:   supplyConfig,
 		db:             db,
 	}
 }
@@ -59,11 +75,24 @@ func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json
 }
 
 // HandleBlock implements modules.BlockModule
-func (m *Module) HandleBlock(block *tmctypes.ResultBlock, _ []*types.Tx, _ *tmctypes.ResultValidators) error {
-	return HandleBlock(block, m.bankClient, m.db)
+func (m *Module) HandleBlock(block *tmctypes.ResultBlock, txs []*types.Tx, _ *tmctypes.ResultValidators) error {
+	return HandleBlock(block, txs, m.blockResults, m.bankClient, m.stakingClient, m.encodingConfig.Marshaler, m.db)
+}
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *types.Tx) error {
+	return HandleTx(tx, m.stakingClient, m.encodingConfig.Marshaler, m.db)
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *types.Tx) error {
-	return HandleMsg(tx, msg, m.messageParser, m.bankClient, m.encodingConfig.Marshaler, m.db)
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *types.Tx) error {
+	return HandleMsg(tx, index, msg, m.messageParser, m.bankClient, m.encodingConfig.Marshaler, m.db)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return RegisterPeriodicOps(
+		scheduler, m.supplyConfig.GetExcludedAddresses(),
+		m.authClient, m.bankClient, m.distrClient, m.encodingConfig.Marshaler, m.db,
+	)
 }
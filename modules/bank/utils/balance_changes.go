@@ -0,0 +1,266 @@
+package utils
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+var (
+	distributionAddress   = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	mintAddress           = authtypes.NewModuleAddress(minttypes.ModuleName).String()
+	feeCollectorAddress   = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	bondedPoolAddress     = authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPoolAddress  = authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	stakingPoolsAddresses = []string{bondedPoolAddress, notBondedPoolAddress}
+)
+
+// balanceChangeKey represents the key used to aggregate the balance changes
+type balanceChangeKey struct {
+	address string
+	denom   string
+	cause   string
+}
+
+// balanceChanges allows to aggregate the changes of the balances by address, denom and cause
+type balanceChanges struct {
+	keys   []balanceChangeKey
+	values map[balanceChangeKey]sdk.Int
+}
+
+func newBalanceChanges() *balanceChanges {
+	return &balanceChanges{
+		values: map[balanceChangeKey]sdk.Int{},
+	}
+}
+
+// add adds the given coins to the balance changes of the provided address, subtracting them if negative is true
+func (b *balanceChanges) add(address string, coins sdk.Coins, cause string, negative bool) {
+	for _, coin := range coins {
+		key := balanceChangeKey{address: address, denom: coin.Denom, cause: cause}
+		value, ok := b.values[key]
+		if !ok {
+			b.keys = append(b.keys, key)
+			value = sdk.ZeroInt()
+		}
+
+		if negative {
+			b.values[key] = value.Sub(coin.Amount)
+		} else {
+			b.values[key] = value.Add(coin.Amount)
+		}
+	}
+}
+
+// toList returns the list of the non-zero balance changes, setting the given height and tx hash
+func (b *balanceChanges) toList(height int64, txHash string) []types.BalanceChange {
+	var changes []types.BalanceChange
+	for _, key := range b.keys {
+		value := b.values[key]
+		if value.IsZero() {
+			continue
+		}
+
+		changes = append(changes, types.NewBalanceChange(key.address, key.denom, value, key.cause, height, txHash))
+	}
+	return changes
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatorBondedResolver tells whether the validator having the given operator address was bonded
+// when the transaction being parsed has been executed
+type ValidatorBondedResolver func(operatorAddress string) (bool, error)
+
+// GetTxBalanceChanges returns all the balance changes caused by the given transaction, including the paid fees.
+// The isBonded resolver is used to get the staking pool that receives the delegated tokens
+func GetTxBalanceChanges(
+	tx *juno.Tx, cdc codec.Marshaler, isBonded ValidatorBondedResolver,
+) ([]types.BalanceChange, error) {
+	changes := newBalanceChanges()
+
+	feePayer, err := getFeePayer(tx, cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	if feePayer != "" && tx.AuthInfo.Fee != nil {
+		changes.add(feePayer, tx.AuthInfo.Fee.Amount, types.BalanceChangeCauseFee, true)
+		changes.add(feeCollectorAddress, tx.AuthInfo.Fee.Amount, types.BalanceChangeCauseFee, false)
+	}
+
+	// Failed transactions only pay the fees
+	if !tx.Successful() {
+		return changes.toList(tx.Height, tx.TxHash), nil
+	}
+
+	for index, msgAny := range tx.Body.Messages {
+		var msg sdk.Msg
+		err = cdc.UnpackAny(msgAny, &msg)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addMsgBalanceChanges(msg, isBonded, changes)
+		if err != nil {
+			return nil, err
+		}
+
+		if index < len(tx.Logs) {
+			addEventsBalanceChanges(tx.Logs[index].Events, false, changes)
+		}
+	}
+
+	return changes.toList(tx.Height, tx.TxHash), nil
+}
+
+// getFeePayer returns the address of the account that has paid the fees of the given transaction
+func getFeePayer(tx *juno.Tx, cdc codec.Marshaler) (string, error) {
+	if tx.AuthInfo.Fee != nil && tx.AuthInfo.Fee.Payer != "" {
+		return tx.AuthInfo.Fee.Payer, nil
+	}
+
+	// The fee payer is the first signer
+	if len(tx.AuthInfo.SignerInfos) == 0 || tx.AuthInfo.SignerInfos[0].PublicKey == nil {
+		return "", nil
+	}
+
+	var pubKey cryptotypes.PubKey
+	err := cdc.UnpackAny(tx.AuthInfo.SignerInfos[0].PublicKey, &pubKey)
+	if err != nil {
+		return "", err
+	}
+
+	return sdk.AccAddress(pubKey.Address()).String(), nil
+}
+
+// addMsgBalanceChanges adds the balance changes caused by the given message that are not present inside its events
+func addMsgBalanceChanges(msg sdk.Msg, isBonded ValidatorBondedResolver, changes *balanceChanges) error {
+	switch cosmosMsg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		// New validators are not bonded until the end of the block, so their tokens go to the not bonded pool
+		coins := sdk.NewCoins(cosmosMsg.Value)
+		changes.add(cosmosMsg.DelegatorAddress, coins, types.BalanceChangeCauseDelegation, true)
+		changes.add(notBondedPoolAddress, coins, types.BalanceChangeCauseDelegation, false)
+
+	case *stakingtypes.MsgDelegate:
+		bonded, err := isBonded(cosmosMsg.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		pool := notBondedPoolAddress
+		if bonded {
+			pool = bondedPoolAddress
+		}
+
+		coins := sdk.NewCoins(cosmosMsg.Amount)
+		changes.add(cosmosMsg.DelegatorAddress, coins, types.BalanceChangeCauseDelegation, true)
+		changes.add(pool, coins, types.BalanceChangeCauseDelegation, false)
+
+	case *banktypes.MsgMultiSend:
+		// The transfer events of a MsgMultiSend only contain the recipients
+		for _, input := range cosmosMsg.Inputs {
+			changes.add(input.Address, input.Coins, types.BalanceChangeCauseTransfer, true)
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// GetBlockBalanceChanges returns all the balance changes that are present inside the
+// given BeginBlock and EndBlock events
+func GetBlockBalanceChanges(height int64, events sdk.StringEvents) []types.BalanceChange {
+	changes := newBalanceChanges()
+	addEventsBalanceChanges(events, true, changes)
+	return changes.toList(height, "")
+}
+
+// addEventsBalanceChanges adds the balance changes that are present inside the given events.
+// isBlock tells whether the events have been emitted during the BeginBlock or EndBlock instead of inside a transaction
+func addEventsBalanceChanges(events sdk.StringEvents, isBlock bool, changes *balanceChanges) {
+	for _, event := range events {
+		switch event.Type {
+		case banktypes.EventTypeTransfer:
+			for _, record := range utils.SplitEventRecords(event) {
+				amount, err := sdk.ParseCoinsNormalized(record[sdk.AttributeKeyAmount])
+				if err != nil {
+					continue
+				}
+
+				sender := record[banktypes.AttributeKeySender]
+				recipient := record[banktypes.AttributeKeyRecipient]
+				cause := getTransferCause(sender, recipient, isBlock)
+				if sender == mintAddress {
+					// The minted coins are created inside the mint module account without emitting any event,
+					// and then sent to the fee collector within the same BeginBlock
+					changes.add(mintAddress, amount, cause, false)
+				}
+				if sender != "" {
+					changes.add(sender, amount, cause, true)
+				}
+				if recipient != "" {
+					changes.add(recipient, amount, cause, false)
+				}
+			}
+
+		case stakingtypes.EventTypeCompleteUnbonding:
+			for _, record := range utils.SplitEventRecords(event) {
+				amount, err := sdk.ParseCoinsNormalized(record[sdk.AttributeKeyAmount])
+				if err != nil {
+					continue
+				}
+
+				// The unbonded tokens are always sent from the not bonded pool, without emitting any transfer event
+				delegator := record[stakingtypes.AttributeKeyDelegator]
+				if delegator != "" {
+					changes.add(delegator, amount, types.BalanceChangeCauseUnbonding, false)
+					changes.add(notBondedPoolAddress, amount, types.BalanceChangeCauseUnbonding, true)
+				}
+			}
+		}
+	}
+}
+
+// getTransferCause returns the cause of a transfer based on the module accounts involved into it.
+// The transfers between module accounts are checked first, as they happen inside every block
+func getTransferCause(sender, recipient string, isBlock bool) string {
+	switch {
+	case sender == mintAddress && recipient == feeCollectorAddress:
+		return types.BalanceChangeCauseReward
+
+	case sender == feeCollectorAddress && recipient == distributionAddress,
+		containsString(stakingPoolsAddresses, sender) && containsString(stakingPoolsAddresses, recipient):
+		return types.BalanceChangeCauseInternal
+
+	case sender == distributionAddress && isBlock:
+		// Outside transactions, the distribution module only sends the community pool spends
+		return types.BalanceChangeCauseTransfer
+
+	case sender == distributionAddress:
+		return types.BalanceChangeCauseReward
+
+	case recipient == feeCollectorAddress:
+		return types.BalanceChangeCauseFee
+
+	case containsString(stakingPoolsAddresses, sender):
+		return types.BalanceChangeCauseUnbonding
+
+	case containsString(stakingPoolsAddresses, recipient):
+		return types.BalanceChangeCauseDelegation
+
+	default:
+		return types.BalanceChangeCauseTransfer
+	}
+}
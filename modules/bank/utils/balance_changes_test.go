@@ -0,0 +1,75 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func TestGetBlockBalanceChanges(t *testing.T) {
+	distrAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	mintAddress := authtypes.NewModuleAddress(minttypes.ModuleName).String()
+	feeCollectorAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	notBondedPoolAddress := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+
+	events := sdk.StringEvents{
+		{
+			Type: "transfer",
+			Attributes: []sdk.Attribute{
+				{Key: "recipient", Value: feeCollectorAddress},
+				{Key: "sender", Value: mintAddress},
+				{Key: "amount", Value: "1000uatom"},
+				{Key: "recipient", Value: distrAddress},
+				{Key: "sender", Value: feeCollectorAddress},
+				{Key: "amount", Value: "1200uatom"},
+			},
+		},
+		{
+			Type: "transfer",
+			Attributes: []sdk.Attribute{
+				{Key: "recipient", Value: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"},
+				{Key: "sender", Value: "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"},
+				{Key: "amount", Value: "100uatom"},
+				{Key: "recipient", Value: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"},
+				{Key: "sender", Value: distrAddress},
+				{Key: "amount", Value: "10uatom"},
+			},
+		},
+		{
+			Type: "complete_unbonding",
+			Attributes: []sdk.Attribute{
+				{Key: "amount", Value: "50uatom"},
+				{Key: "validator", Value: "cosmosvaloper1hafptm4zxy5nw8rd2pxyg83c5ls2v62t4lkfqe"},
+				{Key: "delegator", Value: "cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7"},
+			},
+		},
+	}
+
+	changes := bankutils.GetBlockBalanceChanges(10, events)
+	require.Equal(t, []types.BalanceChange{
+		types.NewBalanceChange(feeCollectorAddress, "uatom", sdk.NewInt(1000),
+			types.BalanceChangeCauseReward, 10, ""),
+		types.NewBalanceChange(feeCollectorAddress, "uatom", sdk.NewInt(-1200),
+			types.BalanceChangeCauseInternal, 10, ""),
+		types.NewBalanceChange(distrAddress, "uatom", sdk.NewInt(1200),
+			types.BalanceChangeCauseInternal, 10, ""),
+		types.NewBalanceChange("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", "uatom", sdk.NewInt(-100),
+			types.BalanceChangeCauseTransfer, 10, ""),
+		types.NewBalanceChange("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", "uatom", sdk.NewInt(110),
+			types.BalanceChangeCauseTransfer, 10, ""),
+		types.NewBalanceChange(distrAddress, "uatom", sdk.NewInt(-10),
+			types.BalanceChangeCauseTransfer, 10, ""),
+		types.NewBalanceChange("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7", "uatom", sdk.NewInt(50),
+			types.BalanceChangeCauseUnbonding, 10, ""),
+		types.NewBalanceChange(notBondedPoolAddress, "uatom", sdk.NewInt(-50),
+			types.BalanceChangeCauseUnbonding, 10, ""),
+	}, changes)
+}
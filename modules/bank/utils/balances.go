@@ -12,24 +12,6 @@ import (
 	"github.com/forbole/bdjuno/types"
 )
 
-// RefreshBalance returns a function that when called refreshes the balance of the user having the given address
-func RefreshBalance(address string, client banktypes.QueryClient, db *database.Db) func() {
-	return func() {
-		height, err := db.GetLastBlockHeight()
-		if err != nil {
-			log.Error().Err(err).Str("module", "bank").
-				Str("operation", "refresh balance").Msg("error while getting latest block height")
-			return
-		}
-
-		err = UpdateBalances([]string{address}, height, client, db)
-		if err != nil {
-			log.Error().Err(err).Str("module", "bank").
-				Str("operation", "refresh balance").Msg("error while updating balance")
-		}
-	}
-}
-
 // UpdateBalances updates the balances of the accounts having the given addresses,
 // taking the data at the provided height
 func UpdateBalances(addresses []string, height int64, bankClient banktypes.QueryClient, db *database.Db) error {
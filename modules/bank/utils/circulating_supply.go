@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/desmos-labs/juno/client"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+
+	"github.com/forbole/bdjuno/database"
+)
+
+// circulatingModuleAccounts contains the names of the module accounts whose balances
+// should be considered part of the circulating supply:
+// - staked tokens are still owned by the delegators
+// - the community pool is subtracted on its own, and the rewards belong to the delegators
+var circulatingModuleAccounts = map[string]bool{
+	stakingtypes.BondedPoolName:    true,
+	stakingtypes.NotBondedPoolName: true,
+	distrtypes.ModuleName:          true,
+}
+
+// UpdateCirculatingSupply computes the circulating supply at the given height and stores it inside the database.
+// The circulating supply is computed as the total supply minus:
+// - the community pool
+// - the balances of the module accounts (excluding the staking pools and the distribution module)
+// - the coins that are still locked inside vesting accounts
+// - the balances of the given excluded addresses
+func UpdateCirculatingSupply(
+	height int64, blockTime time.Time, excludedAddresses []string,
+	authClient authttypes.QueryClient, bankClient banktypes.QueryClient, distrClient distrtypes.QueryClient,
+	cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "bank").Int64("height", height).
+		Msg("updating circulating supply")
+
+	header := client.GetHeightRequestHeader(height)
+
+	supplyRes, err := bankClient.TotalSupply(context.Background(), &banktypes.QueryTotalSupplyRequest{}, header)
+	if err != nil {
+		return err
+	}
+
+	poolRes, err := distrClient.CommunityPool(context.Background(), &distrtypes.QueryCommunityPoolRequest{}, header)
+	if err != nil {
+		return err
+	}
+
+	communityPool, _ := poolRes.Pool.TruncateDecimal()
+	nonCirculating := communityPool
+
+	lockedCoins, err := getLockedCoins(blockTime, authClient, bankClient, cdc, header)
+	if err != nil {
+		return err
+	}
+	nonCirculating = nonCirculating.Add(lockedCoins...)
+
+	for _, address := range excludedAddresses {
+		balance, err := getBalance(address, bankClient, header)
+		if err != nil {
+			return err
+		}
+		nonCirculating = nonCirculating.Add(balance...)
+	}
+
+	return db.SaveCirculatingSupply(safeSub(supplyRes.Supply, nonCirculating), height)
+}
+
+// getLockedCoins iterates over all the accounts and returns the sum of the balances of the module accounts
+// that are not considered circulating, plus the amounts that are still vesting inside vesting accounts
+func getLockedCoins(
+	blockTime time.Time, authClient authttypes.QueryClient, bankClient banktypes.QueryClient, cdc codec.Marshaler, header grpc.CallOption,
+) (sdk.Coins, error) {
+	var locked = sdk.NewCoins()
+
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := authClient.Accounts(
+			context.Background(),
+			&authttypes.QueryAccountsRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 accounts at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, acc := range res.Accounts {
+			var account authttypes.AccountI
+			err = cdc.UnpackAny(acc, &account)
+			if err != nil {
+				return nil, err
+			}
+
+			switch account := account.(type) {
+			case authttypes.ModuleAccountI:
+				if circulatingModuleAccounts[account.GetName()] {
+					continue
+				}
+
+				balance, err := getBalance(account.GetAddress().String(), bankClient, header)
+				if err != nil {
+					return nil, err
+				}
+				locked = locked.Add(balance...)
+
+			case vestexported.VestingAccount:
+				locked = locked.Add(account.GetVestingCoins(blockTime)...)
+			}
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+	}
+
+	return locked, nil
+}
+
+// getBalance returns the balance of the account having the given address
+func getBalance(address string, bankClient banktypes.QueryClient, header grpc.CallOption) (sdk.Coins, error) {
+	res, err := bankClient.AllBalances(
+		context.Background(),
+		&banktypes.QueryAllBalancesRequest{Address: address},
+		header,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Balances, nil
+}
+
+// safeSub subtracts the given amount from the provided coins, making sure no negative amount is returned
+func safeSub(coins sdk.Coins, amount sdk.Coins) sdk.Coins {
+	var result = sdk.NewCoins()
+	for _, coin := range coins {
+		diff := coin.Amount.Sub(amount.AmountOf(coin.Denom))
+		if diff.IsPositive() {
+			result = result.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/bdjuno/modules/utils"
+)
+
+const (
+	// EventTypeCoinSpent and EventTypeCoinReceived are emitted by the x/bank module of newer
+	// Cosmos SDK versions each time that some coins are removed from or added to an account
+	EventTypeCoinSpent    = "coin_spent"
+	EventTypeCoinReceived = "coin_received"
+
+	AttributeKeySpender  = "spender"
+	AttributeKeyReceiver = "receiver"
+)
+
+// balanceChangeAttributes contains, for each event type, the attributes
+// whose values represent the addresses of the accounts whose balance has changed
+var balanceChangeAttributes = map[string][]string{
+	EventTypeCoinSpent:                      {AttributeKeySpender},
+	EventTypeCoinReceived:                   {AttributeKeyReceiver},
+	banktypes.EventTypeTransfer:             {banktypes.AttributeKeySender, banktypes.AttributeKeyRecipient},
+	stakingtypes.EventTypeCompleteUnbonding: {stakingtypes.AttributeKeyDelegator},
+}
+
+// GetBalanceChangesAddresses returns the addresses of all the accounts
+// whose balance has been changed based on the given events
+func GetBalanceChangesAddresses(events sdk.StringEvents) []string {
+	var addresses []string
+	var found = map[string]bool{}
+	for _, event := range events {
+		keys, ok := balanceChangeAttributes[event.Type]
+		if !ok {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if !containsString(keys, attr.Key) || found[attr.Value] {
+				continue
+			}
+
+			found[attr.Value] = true
+			addresses = append(addresses, attr.Value)
+		}
+	}
+
+	return utils.FilterNonAccountAddresses(addresses)
+}
+
+// MergeAddresses returns a new slice containing all the given addresses without duplicates
+func MergeAddresses(first, second []string) []string {
+	var addresses []string
+	for _, address := range append(first, second...) {
+		if !containsString(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// containsString tells whether the given slice contains the provided value
+func containsString(slice []string, value string) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
+)
+
+func TestGetBalanceChangesAddresses(t *testing.T) {
+	events := sdk.StringEvents{
+		{
+			Type: "coin_spent",
+			Attributes: []sdk.Attribute{
+				{Key: "spender", Value: "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"},
+				{Key: "amount", Value: "100uatom"},
+			},
+		},
+		{
+			Type: "coin_received",
+			Attributes: []sdk.Attribute{
+				{Key: "receiver", Value: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"},
+				{Key: "amount", Value: "100uatom"},
+			},
+		},
+		{
+			Type: "transfer",
+			Attributes: []sdk.Attribute{
+				{Key: "recipient", Value: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"},
+				{Key: "sender", Value: "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"},
+				{Key: "amount", Value: "100uatom"},
+			},
+		},
+		{
+			Type: "complete_unbonding",
+			Attributes: []sdk.Attribute{
+				{Key: "validator", Value: "cosmosvaloper1hafptm4zxy5nw8rd2pxyg83c5ls2v62t4lkfqe"},
+				{Key: "delegator", Value: "cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7"},
+			},
+		},
+		{
+			Type: "message",
+			Attributes: []sdk.Attribute{
+				{Key: "sender", Value: "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"},
+			},
+		},
+	}
+
+	addresses := bankutils.GetBalanceChangesAddresses(events)
+	require.Equal(t, []string{
+		"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2",
+		"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		"cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7",
+	}, addresses)
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/desmos-labs/juno/client"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/types"
+)
+
+// HasSlashEvents tells whether the given block events, or the events of the given transactions, contain any slash
+func HasSlashEvents(events sdk.StringEvents, txs []*juno.Tx) bool {
+	if containsSlashEvent(events) {
+		return true
+	}
+
+	for _, tx := range txs {
+		for _, log := range tx.Logs {
+			if containsSlashEvent(log.Events) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// containsSlashEvent tells whether the given events contain a slash event
+func containsSlashEvent(events sdk.StringEvents) bool {
+	for _, event := range events {
+		if event.Type == slashingtypes.EventTypeSlash {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStakingPoolsBalances returns the balances of the staking pools at the given height, indexed by address
+func GetStakingPoolsBalances(height int64, bankClient banktypes.QueryClient) (map[string]sdk.Coins, error) {
+	header := client.GetHeightRequestHeader(height)
+
+	var balances = map[string]sdk.Coins{}
+	for _, address := range stakingPoolsAddresses {
+		res, err := bankClient.AllBalances(
+			context.Background(),
+			&banktypes.QueryAllBalancesRequest{Address: address},
+			header,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		balances[address] = res.Balances
+	}
+
+	return balances, nil
+}
+
+// GetSlashBalanceChanges returns the balance changes caused by the slashes that happened at the given height.
+// Since the slash events do not contain the burned amounts, the amount burned from each staking pool is computed as
+// the difference between the change of its balance during the block and the given changes recorded for it by all
+// the other causes. This covers the slashes of the validators tokens, as well as the ones of their unbonding
+// delegations and redelegations, whether they are burned from the bonded or the not bonded pool
+func GetSlashBalanceChanges(
+	height int64, previousBalances, currentBalances map[string]sdk.Coins, recorded []types.BalanceChange,
+) []types.BalanceChange {
+	changes := newBalanceChanges()
+	for _, pool := range stakingPoolsAddresses {
+		var denoms []string
+		var deltas = map[string]sdk.Int{}
+		addDelta := func(denom string, amount sdk.Int) {
+			delta, ok := deltas[denom]
+			if !ok {
+				denoms = append(denoms, denom)
+				delta = sdk.ZeroInt()
+			}
+			deltas[denom] = delta.Add(amount)
+		}
+
+		for _, coin := range currentBalances[pool] {
+			addDelta(coin.Denom, coin.Amount)
+		}
+
+		for _, coin := range previousBalances[pool] {
+			addDelta(coin.Denom, coin.Amount.Neg())
+		}
+
+		for _, change := range recorded {
+			if change.Address == pool {
+				addDelta(change.Denom, change.Amount.Neg())
+			}
+		}
+
+		for _, denom := range denoms {
+			// Only the burned coins are left once the other changes have been removed
+			if deltas[denom].IsNegative() {
+				changes.add(pool, sdk.NewCoins(sdk.NewCoin(denom, deltas[denom].Neg())), types.BalanceChangeCauseSlash, true)
+			}
+		}
+	}
+
+	return changes.toList(height, "")
+}
@@ -0,0 +1,45 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func TestGetSlashBalanceChanges(t *testing.T) {
+	bondedPoolAddress := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPoolAddress := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+
+	previousBalances := map[string]sdk.Coins{
+		bondedPoolAddress:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000)),
+		notBondedPoolAddress: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50000)),
+	}
+	currentBalances := map[string]sdk.Coins{
+		bondedPoolAddress:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 1010000)),
+		notBondedPoolAddress: sdk.NewCoins(sdk.NewInt64Coin("uatom", 39000)),
+	}
+
+	// A delegation and an unbonding completion happened inside the same block of the slashes
+	recorded := []types.BalanceChange{
+		types.NewBalanceChange("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", "uatom", sdk.NewInt(-20000),
+			types.BalanceChangeCauseDelegation, 10, "hash"),
+		types.NewBalanceChange(bondedPoolAddress, "uatom", sdk.NewInt(20000),
+			types.BalanceChangeCauseDelegation, 10, "hash"),
+		types.NewBalanceChange(notBondedPoolAddress, "uatom", sdk.NewInt(-10000),
+			types.BalanceChangeCauseUnbonding, 10, ""),
+	}
+
+	changes := bankutils.GetSlashBalanceChanges(10, previousBalances, currentBalances, recorded)
+	require.Equal(t, []types.BalanceChange{
+		types.NewBalanceChange(bondedPoolAddress, "uatom", sdk.NewInt(-10000),
+			types.BalanceChangeCauseSlash, 10, ""),
+		types.NewBalanceChange(notBondedPoolAddress, "uatom", sdk.NewInt(-1000),
+			types.BalanceChangeCauseSlash, 10, ""),
+	}, changes)
+}
@@ -7,15 +7,23 @@ import (
 
 	"github.com/forbole/bdjuno/database"
 	"github.com/forbole/bdjuno/modules/distribution/utils"
+	"github.com/forbole/bdjuno/types"
 )
 
 // HandleMsg allows to handle the different utils related to the distribution module
-func HandleMsg(tx *juno.Tx, msg sdk.Msg, client distrtypes.QueryClient, db *database.Db) error {
+func HandleMsg(tx *juno.Tx, index int, msg sdk.Msg, client distrtypes.QueryClient, db *database.Db) error {
 	if len(tx.Logs) == 0 {
 		return nil
 	}
 
-	if _, ok := msg.(*distrtypes.MsgFundCommunityPool); ok {
+	if fundMsg, ok := msg.(*distrtypes.MsgFundCommunityPool); ok {
+		err := db.SaveCommunityPoolFund(
+			types.NewCommunityPoolFund(fundMsg.Depositor, fundMsg.Amount, tx.TxHash, index, tx.Height),
+		)
+		if err != nil {
+			return err
+		}
+
 		return utils.UpdateCommunityPool(tx.Height, client, db)
 	}
 
@@ -1,6 +1,8 @@
 package distribution
 
 import (
+	"fmt"
+
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/go-co-op/gocron"
 	"github.com/rs/zerolog/log"
@@ -8,14 +10,20 @@ import (
 	"github.com/forbole/bdjuno/database"
 	distrutils "github.com/forbole/bdjuno/modules/distribution/utils"
 	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types/config"
 )
 
 // RegisterPeriodicOps registers the additional utils that periodically run
 func RegisterPeriodicOps(
-	scheduler *gocron.Scheduler, distrClient distrtypes.QueryClient, db *database.Db,
+	scheduler *gocron.Scheduler, distrCfg *config.DistributionConfig, distrClient distrtypes.QueryClient, db *database.Db,
 ) error {
 	log.Debug().Str("module", "distribution").Msg("setting up periodic tasks")
 
+	// The realized APR is computed using the delegations and rewards history
+	if distrCfg.ShouldComputeRealizedAPR() && !db.IsStoreHistoricDataEnabled() {
+		return fmt.Errorf("the realized APR computation requires the historic data to be stored")
+	}
+
 	// Update the community pool every 1 hour
 	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
 		utils.WatchMethod(func() error { return getLatestCommunityPool(distrClient, db) })
@@ -23,6 +31,13 @@ func RegisterPeriodicOps(
 		return err
 	}
 
+	// Update the staking APR every 1 hour
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return updateStakingAPR(distrCfg.ShouldComputeRealizedAPR(), db) })
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -35,3 +50,13 @@ func getLatestCommunityPool(distrClient distrtypes.QueryClient, db *database.Db)
 
 	return distrutils.UpdateCommunityPool(height, distrClient, db)
 }
+
+// updateStakingAPR computes the latest staking APR values and stores them inside the database
+func updateStakingAPR(computeRealized bool, db *database.Db) error {
+	block, err := db.GetLastBlock()
+	if err != nil {
+		return err
+	}
+
+	return distrutils.UpdateStakingAPR(block, computeRealized, db)
+}
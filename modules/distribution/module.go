@@ -5,6 +5,7 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types/config"
 
 	"github.com/desmos-labs/juno/modules"
 	"github.com/desmos-labs/juno/types"
@@ -23,12 +24,14 @@ var (
 type Module struct {
 	db          *database.Db
 	distrClient distrtypes.QueryClient
+	distrConfig *config.DistributionConfig
 }
 
 // NewModule returns a new Module instance
-func NewModule(distrClient distrtypes.QueryClient, db *database.Db) *Module {
+func NewModule(distrConfig *config.DistributionConfig, distrClient distrtypes.QueryClient, db *database.Db) *Module {
 	return &Module{
 		distrClient: distrClient,
+		distrConfig: distrConfig,
 		db:          db,
 	}
 }
@@ -40,7 +43,7 @@ func (m *Module) Name() string {
 
 // RegisterPeriodicOperations implements modules.PeriodicOperationsModule
 func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
-	return RegisterPeriodicOps(scheduler, m.distrClient, m.db)
+	return RegisterPeriodicOps(scheduler, m.distrConfig, m.distrClient, m.db)
 }
 
 // HandleBlock implements modules.BlockModule
@@ -49,6 +52,6 @@ func (m *Module) HandleBlock(b *tmctypes.ResultBlock, _ []*types.Tx, _ *tmctypes
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *types.Tx) error {
-	return HandleMsg(tx, msg, m.distrClient, m.db)
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *types.Tx) error {
+	return HandleMsg(tx, index, msg, m.distrClient, m.db)
 }
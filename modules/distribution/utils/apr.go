@@ -0,0 +1,220 @@
+package utils
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	dbtypes "github.com/forbole/bdjuno/database/types"
+	"github.com/forbole/bdjuno/types"
+)
+
+const (
+	// compoundingPeriods represents the number of times per year rewards are assumed to be restaked
+	// when computing the APY
+	compoundingPeriods = 365
+
+	year = 365 * 24 * time.Hour
+
+	// realizedAPRHeightDelay represents the number of blocks that the realized APR computation stays behind the
+	// latest stored block. The delegation rewards history is written asynchronously while parsing each block,
+	// so the rewards of the most recent heights might not be complete yet
+	realizedAPRHeightDelay = 100
+)
+
+// UpdateStakingAPR computes the network staking APR as well as the APR of each validator
+// using the data stored inside the database, and stores them for the given block.
+// When computeRealized is true, the realized APR is also computed looking at
+// the actual rewards accrual stored inside the delegation rewards history.
+func UpdateStakingAPR(block *dbtypes.BlockRow, computeRealized bool, db *database.Db) error {
+	log.Debug().Str("module", "distribution").Int64("height", block.Height).
+		Msg("updating staking APR")
+
+	apr, err := getNetworkAPR(db)
+	if err != nil {
+		return err
+	}
+
+	var realizedAPRs map[string]sdk.Dec
+	var networkRealizedAPR *sdk.Dec
+	if computeRealized {
+		realizedAPRs, networkRealizedAPR, err = getRealizedAPRs(block, db)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.SaveStakingAPR(types.NewStakingAPR(apr, aprToAPY(apr), networkRealizedAPR, block.Height))
+	if err != nil {
+		return err
+	}
+
+	rates, err := db.GetValidatorsCommissionRates()
+	if err != nil {
+		return err
+	}
+
+	var validatorsAPRs = make([]types.ValidatorAPR, 0, len(rates))
+	for consAddr, rate := range rates {
+		validatorAPR := apr.Mul(sdk.OneDec().Sub(rate))
+
+		var realizedAPR *sdk.Dec
+		if value, ok := realizedAPRs[consAddr]; ok {
+			realizedAPR = &value
+		}
+
+		validatorsAPRs = append(validatorsAPRs, types.NewValidatorAPR(
+			consAddr, validatorAPR, aprToAPY(validatorAPR), realizedAPR, block.Height,
+		))
+	}
+
+	return db.SaveValidatorsAPR(validatorsAPRs)
+}
+
+// getNetworkAPR returns the staking APR of the network computed as
+// inflation * (1 - community tax) * bond denom supply / bonded tokens
+func getNetworkAPR(db *database.Db) (sdk.Dec, error) {
+	inflation, err := db.GetInflation()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	params, err := db.GetDistributionParams()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	stakingParams, err := db.GetStakingParams()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	supply, err := db.GetSupply()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	pool, err := db.GetStakingPool()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if !pool.BondedTokens.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	return inflation.
+		Mul(sdk.OneDec().Sub(params.CommunityTax)).
+		MulInt(supply.AmountOf(stakingParams.BondDenom)).
+		QuoInt(pool.BondedTokens), nil
+}
+
+// aprToAPY converts the given APR to the APY obtained restaking the rewards compoundingPeriods times per year
+func aprToAPY(apr sdk.Dec) sdk.Dec {
+	return apr.QuoInt64(compoundingPeriods).Add(sdk.OneDec()).Power(compoundingPeriods).Sub(sdk.OneDec())
+}
+
+// getRealizedAPRs computes the realized APR of each validator, as well as the one of the whole network,
+// comparing the delegation rewards of the block created realizedAPRHeightDelay blocks before the given one with the
+// ones of the block created one day before it, relative to the amounts delegated at the start of such period.
+// Delegations whose rewards decreased during the period (eg. due to a withdrawal) are ignored.
+func getRealizedAPRs(latestBlock *dbtypes.BlockRow, db *database.Db) (map[string]sdk.Dec, *sdk.Dec, error) {
+	block, err := db.GetBlock(latestBlock.Height - realizedAPRHeightDelay)
+	if err != nil || block == nil {
+		return nil, nil, err
+	}
+
+	startBlock, err := db.GetBlockHeightTimeDayAgo(block.Timestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	elapsed := block.Timestamp.Sub(startBlock.Timestamp)
+	if elapsed <= 0 {
+		return nil, nil, nil
+	}
+
+	stakingParams, err := db.GetStakingParams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startRewards, err := getDelegationRewards(startBlock.Height, stakingParams.BondDenom, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endRewards, err := getDelegationRewards(block.Height, stakingParams.BondDenom, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delegations, err := db.GetDelegationsAtHeight(startBlock.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accrued = map[string]sdk.Dec{}
+	var delegated = map[string]sdk.Dec{}
+	var totalAccrued, totalDelegated = sdk.ZeroDec(), sdk.ZeroDec()
+	for _, delegation := range delegations {
+		key := delegation.ValidatorAddress + delegation.DelegatorAddress
+		start, foundStart := startRewards[key]
+		end, foundEnd := endRewards[key]
+		if !foundStart || !foundEnd || end.LT(start) {
+			continue
+		}
+
+		amount, err := sdk.NewDecFromStr(delegation.Amount.Amount)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, ok := accrued[delegation.ValidatorAddress]; !ok {
+			accrued[delegation.ValidatorAddress] = sdk.ZeroDec()
+			delegated[delegation.ValidatorAddress] = sdk.ZeroDec()
+		}
+
+		accrued[delegation.ValidatorAddress] = accrued[delegation.ValidatorAddress].Add(end.Sub(start))
+		delegated[delegation.ValidatorAddress] = delegated[delegation.ValidatorAddress].Add(amount)
+		totalAccrued = totalAccrued.Add(end.Sub(start))
+		totalDelegated = totalDelegated.Add(amount)
+	}
+
+	var realized = make(map[string]sdk.Dec, len(accrued))
+	for validator, amount := range accrued {
+		if delegated[validator].IsPositive() {
+			realized[validator] = annualize(amount.Quo(delegated[validator]), elapsed)
+		}
+	}
+
+	if !totalDelegated.IsPositive() {
+		return realized, nil, nil
+	}
+
+	networkRealized := annualize(totalAccrued.Quo(totalDelegated), elapsed)
+	return realized, &networkRealized, nil
+}
+
+// getDelegationRewards returns the bond denom rewards amounts stored for the given height,
+// indexed by the concatenation of the validator and delegator addresses
+func getDelegationRewards(height int64, bondDenom string, db *database.Db) (map[string]sdk.Dec, error) {
+	rows, err := db.GetDelegationRewardsHistory(height)
+	if err != nil {
+		return nil, err
+	}
+
+	var rewards = make(map[string]sdk.Dec, len(rows))
+	for _, row := range rows {
+		rewards[row.ValidatorConsAddress+row.DelegatorAddress] = row.Amount.ToDecCoins().AmountOf(bondDenom)
+	}
+
+	return rewards, nil
+}
+
+// annualize converts the given rate obtained during the elapsed period to a yearly one
+func annualize(rate sdk.Dec, elapsed time.Duration) sdk.Dec {
+	return rate.MulInt64(int64(year)).QuoInt64(int64(elapsed))
+}
@@ -6,14 +6,19 @@ import (
 	"github.com/forbole/bdjuno/database"
 
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/desmos-labs/juno/client"
 	"github.com/rs/zerolog/log"
 )
 
 // UpdateCommunityPool fetch total amount of coins in the system from RPC and store it into database
-func UpdateCommunityPool(height int64, client distrtypes.QueryClient, db *database.Db) error {
+func UpdateCommunityPool(height int64, distrClient distrtypes.QueryClient, db *database.Db) error {
 	log.Debug().Str("module", "distribution").Int64("height", height).Msg("getting community pool")
 
-	res, err := client.CommunityPool(context.Background(), &distrtypes.QueryCommunityPoolRequest{})
+	res, err := distrClient.CommunityPool(
+		context.Background(),
+		&distrtypes.QueryCommunityPoolRequest{},
+		client.GetHeightRequestHeader(height),
+	)
 	if err != nil {
 		return err
 	}
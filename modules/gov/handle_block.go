@@ -2,12 +2,14 @@ package gov
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/desmos-labs/juno/client"
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/rs/zerolog/log"
@@ -16,20 +18,29 @@ import (
 
 	"github.com/forbole/bdjuno/database"
 	govutils "github.com/forbole/bdjuno/modules/gov/utils"
+	stakingutils "github.com/forbole/bdjuno/modules/staking/utils"
+	"github.com/forbole/bdjuno/modules/utils"
 )
 
-// HandleBlock handles a new block by updating any eventually open proposal's status and tally result
+// HandleBlock handles a new block by updating any eventually open proposal's status, tally result and tally projection
 func HandleBlock(
-	height int64, blockVals *tmctypes.ResultValidators,
+	block *tmctypes.ResultBlock, blockVals *tmctypes.ResultValidators, blockResults *utils.BlockResultsCache,
 	govClient govtypes.QueryClient, bankClient banktypes.QueryClient, stakingClient stakingtypes.QueryClient,
 	cdc codec.Marshaler, db *database.Db,
 ) error {
+	height := block.Block.Height
 	err := updateProposals(height, blockVals, govClient, bankClient, stakingClient, cdc, db)
 	if err != nil {
 		log.Error().Str("module", "gov").Int64("height", height).
 			Err(err).Msg("error while updating proposals")
 	}
 
+	err = handleEndBlockEvents(block, blockResults, govClient, stakingClient, cdc, db)
+	if err != nil {
+		log.Error().Str("module", "gov").Int64("height", height).
+			Err(err).Msg("error while handling end block events")
+	}
+
 	err = updateParams(height, govClient, db)
 	if err != nil {
 		log.Error().Str("module", "gov").Int64("height", height).
@@ -98,3 +109,118 @@ func updateProposals(
 	}
 	return nil
 }
+
+// handleEndBlockEvents stores the statuses reached by the proposals that have ended during the EndBlock
+// of the given block, along with the outcome of their deposits and the breakdown of their tally.
+// Passed proposals are also linked to the params changes they have caused
+func handleEndBlockEvents(
+	block *tmctypes.ResultBlock, blockResults *utils.BlockResultsCache,
+	govClient govtypes.QueryClient, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	height := block.Block.Height
+	res, err := blockResults.GetBlockResults(height)
+	if err != nil {
+		return err
+	}
+
+	events := sdk.StringifyEvents(res.EndBlockEvents)
+	for _, proposal := range govutils.GetEndedProposals(events) {
+		err = db.SaveProposalStatusChanges([]types.ProposalStatusChange{
+			types.NewProposalStatusChange(proposal.ProposalID, proposal.GetStatus(), height, block.Block.Time),
+		})
+		if err != nil {
+			return err
+		}
+
+		if proposal.GetStatus() == govtypes.StatusPassed.String() {
+			err = db.SetParamsHistoryProposal(proposal.ProposalID, height)
+			if err != nil {
+				return err
+			}
+
+			err = saveCommunityPoolSpend(proposal.ProposalID, height, db)
+			if err != nil {
+				return err
+			}
+		}
+
+		if proposal.Result != govtypes.AttributeValueProposalDropped {
+			err = govutils.UpdateEffectiveTally(height, proposal.ProposalID, stakingClient, cdc, db)
+			if err != nil {
+				return err
+			}
+		}
+
+		outcome, err := getDepositOutcome(height, proposal, govClient, stakingClient)
+		if err != nil {
+			return err
+		}
+
+		err = db.SaveDepositOutcome(outcome)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDepositOutcome returns the outcome of the deposits of the given proposal that has ended at the provided height.
+// The final tally of rejected proposals is compared with the tally params and bonded tokens at the same height
+func getDepositOutcome(
+	height int64, proposal govutils.EndedProposal, govClient govtypes.QueryClient, stakingClient stakingtypes.QueryClient,
+) (types.DepositOutcome, error) {
+	if proposal.Result != govtypes.AttributeValueProposalRejected {
+		return govutils.GetDepositOutcome(proposal, govtypes.TallyParams{}, govtypes.TallyResult{}, sdk.ZeroInt(), height), nil
+	}
+
+	header := client.GetHeightRequestHeader(height)
+	proposalRes, err := govClient.Proposal(
+		context.Background(),
+		&govtypes.QueryProposalRequest{ProposalId: proposal.ProposalID},
+		header,
+	)
+	if err != nil {
+		return types.DepositOutcome{}, fmt.Errorf("error while getting proposal: %s", err)
+	}
+
+	paramsRes, err := govClient.Params(
+		context.Background(),
+		&govtypes.QueryParamsRequest{ParamsType: govtypes.ParamTallying},
+		header,
+	)
+	if err != nil {
+		return types.DepositOutcome{}, fmt.Errorf("error while getting tally params: %s", err)
+	}
+
+	pool, err := stakingutils.GetStakingPool(height, stakingClient)
+	if err != nil {
+		return types.DepositOutcome{}, fmt.Errorf("error while getting staking pool: %s", err)
+	}
+
+	return govutils.GetDepositOutcome(
+		proposal, paramsRes.TallyParams, proposalRes.Proposal.FinalTallyResult, pool.BondedTokens, height,
+	), nil
+}
+
+// saveCommunityPoolSpend stores the funds sent from the community pool to the recipient of the given
+// passed proposal, if it is a CommunityPoolSpendProposal
+func saveCommunityPoolSpend(proposalID uint64, height int64, db *database.Db) error {
+	content, err := db.GetProposalCommunityPoolSpend(proposalID)
+	if err != nil {
+		return err
+	}
+
+	if content == nil {
+		return nil
+	}
+
+	err = db.SaveAccounts([]types.Account{types.NewAccount(content.Recipient)})
+	if err != nil {
+		return err
+	}
+
+	return db.SaveCommunityPoolSpend(
+		types.NewCommunityPoolSpend(proposalID, content.Recipient, content.Amount, height),
+	)
+}
@@ -5,14 +5,18 @@ import (
 	"fmt"
 
 	"github.com/forbole/bdjuno/database"
+	govutils "github.com/forbole/bdjuno/modules/gov/utils"
 	"github.com/forbole/bdjuno/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/rs/zerolog/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-func HandleGenesis(appState map[string]json.RawMessage, cdc codec.Marshaler, db *database.Db) error {
+func HandleGenesis(
+	doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage, cdc codec.Marshaler, db *database.Db,
+) error {
 	log.Debug().Str("module", "gov").Msg("parsing genesis")
 
 	// Read the genesis state
@@ -28,6 +32,18 @@ func HandleGenesis(appState map[string]json.RawMessage, cdc codec.Marshaler, db
 		return fmt.Errorf("error while storing genesis governance proposals: %s", err)
 	}
 
+	// Save the typed contents of the proposals
+	err = saveProposalsContents(genState.Proposals, cdc, db)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis governance proposals contents: %s", err)
+	}
+
+	// Save the statuses of the proposals
+	err = saveProposalsStatuses(doc, genState.Proposals, db)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis governance proposals statuses: %s", err)
+	}
+
 	return nil
 }
 
@@ -84,3 +100,30 @@ func saveProposals(slice govtypes.Proposals, db *database.Db) error {
 	// Save the tally results
 	return db.SaveTallyResults(tallyResults)
 }
+
+// saveProposalsContents stores the typed contents of the genesis proposals
+func saveProposalsContents(slice govtypes.Proposals, cdc codec.Marshaler, db *database.Db) error {
+	for _, proposal := range slice {
+		err := govutils.SaveProposalContent(proposal.ProposalId, proposal.GetContent(), cdc, db)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveProposalsStatuses stores the statuses that the genesis proposals have at the genesis height
+func saveProposalsStatuses(doc *tmtypes.GenesisDoc, slice govtypes.Proposals, db *database.Db) error {
+	changes := make([]types.ProposalStatusChange, len(slice))
+	for index, proposal := range slice {
+		changes[index] = types.NewProposalStatusChange(
+			proposal.ProposalId,
+			proposal.Status.String(),
+			doc.InitialHeight,
+			doc.GenesisTime,
+		)
+	}
+
+	return db.SaveProposalStatusChanges(changes)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/desmos-labs/juno/client"
 
 	"github.com/forbole/bdjuno/database"
+	govutils "github.com/forbole/bdjuno/modules/gov/utils"
 	"github.com/forbole/bdjuno/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,7 +33,7 @@ func HandleMsg(
 		return handleMsgSubmitProposal(tx, index, cosmosMsg, govClient, cdc, db)
 
 	case *govtypes.MsgDeposit:
-		return handleMsgDeposit(tx, cosmosMsg, govClient, db)
+		return handleMsgDeposit(tx, index, cosmosMsg, govClient, db)
 
 	case *govtypes.MsgVote:
 		return handleMsgVote(tx, cosmosMsg, db)
@@ -97,13 +99,53 @@ func handleMsgSubmitProposal(
 		return err
 	}
 
+	// Store the typed content
+	err = govutils.SaveProposalContent(proposal.ProposalId, content, cdc, db)
+	if err != nil {
+		return err
+	}
+
+	// Store the statuses reached by the proposal
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	changes := []types.ProposalStatusChange{
+		types.NewProposalStatusChange(proposalID, govtypes.StatusDepositPeriod.String(), tx.Height, timestamp),
+	}
+	if govutils.HasVotingPeriodStarted(event, proposalID) {
+		changes = append(changes,
+			types.NewProposalStatusChange(proposalID, govtypes.StatusVotingPeriod.String(), tx.Height, timestamp),
+		)
+	}
+
+	err = db.SaveProposalStatusChanges(changes)
+	if err != nil {
+		return err
+	}
+
 	// Store the deposit
 	deposit := types.NewDeposit(proposal.ProposalId, msg.Proposer, msg.InitialDeposit, tx.Height)
+	if !deposit.Amount.IsZero() {
+		err = db.SaveDepositHistory(deposit, tx.TxHash)
+		if err != nil {
+			return err
+		}
+	}
+
 	return db.SaveDeposits([]types.Deposit{deposit})
 }
 
 // handleMsgDeposit allows to properly handle a handleMsgDeposit
-func handleMsgDeposit(tx *juno.Tx, msg *govtypes.MsgDeposit, govClient govtypes.QueryClient, db *database.Db) error {
+func handleMsgDeposit(
+	tx *juno.Tx, index int, msg *govtypes.MsgDeposit, govClient govtypes.QueryClient, db *database.Db,
+) error {
+	err := handleVotingPeriodStart(tx, index, msg.ProposalId, db)
+	if err != nil {
+		return err
+	}
+
 	header := client.GetHeightRequestHeader(tx.Height)
 	res, err := govClient.Deposit(
 		context.Background(),
@@ -114,12 +156,45 @@ func handleMsgDeposit(tx *juno.Tx, msg *govtypes.MsgDeposit, govClient govtypes.
 		return fmt.Errorf("error while getting proposal deposit: %s", err)
 	}
 
+	err = db.SaveDepositHistory(types.NewDeposit(msg.ProposalId, msg.Depositor, msg.Amount, tx.Height), tx.TxHash)
+	if err != nil {
+		return err
+	}
+
 	deposit := types.NewDeposit(msg.ProposalId, msg.Depositor, res.Deposit.Amount, tx.Height)
 	return db.SaveDeposits([]types.Deposit{deposit})
 }
 
+// handleVotingPeriodStart stores the start of the voting period of the proposal having the given id
+// if the deposit contained inside the message having the given index has made it start
+func handleVotingPeriodStart(tx *juno.Tx, index int, proposalID uint64, db *database.Db) error {
+	event, err := tx.FindEventByType(index, govtypes.EventTypeProposalDeposit)
+	if err != nil {
+		return err
+	}
+
+	if !govutils.HasVotingPeriodStarted(event, proposalID) {
+		return nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveProposalStatusChanges([]types.ProposalStatusChange{
+		types.NewProposalStatusChange(proposalID, govtypes.StatusVotingPeriod.String(), tx.Height, timestamp),
+	})
+}
+
 // handleMsgVote allows to properly handle a handleMsgVote
 func handleMsgVote(tx *juno.Tx, msg *govtypes.MsgVote, db *database.Db) error {
 	vote := types.NewVote(msg.ProposalId, msg.Voter, msg.Option, tx.Height)
+
+	err := db.SaveVoteHistory(vote, tx.TxHash)
+	if err != nil {
+		return err
+	}
+
 	return db.SaveVote(vote)
 }
@@ -8,6 +8,7 @@ import (
 	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/modules/utils"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -29,6 +30,7 @@ var (
 // Module represent x/gov module
 type Module struct {
 	encodingConfig *params.EncodingConfig
+	blockResults   *utils.BlockResultsCache
 	govClient      govtypes.QueryClient
 	bankClient     banktypes.QueryClient
 	stakingClient  stakingtypes.QueryClient
@@ -37,11 +39,13 @@ type Module struct {
 
 // NewModule returns a new Module instance
 func NewModule(
+	blockResults *utils.BlockResultsCache,
 	bankClient banktypes.QueryClient, govClient govtypes.QueryClient, stakingClient stakingtypes.QueryClient,
 	encodingConfig *params.EncodingConfig, db *database.Db,
 ) *Module {
 	return &Module{
 		encodingConfig: encodingConfig,
+		blockResults:   blockResults,
 		govClient:      govClient,
 		bankClient:     bankClient,
 		stakingClient:  stakingClient,
@@ -55,13 +59,13 @@ func (m *Module) Name() string {
 }
 
 // HandleGenesis implements modules.Module
-func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
-	return HandleGenesis(appState, m.encodingConfig.Marshaler, m.db)
+func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+	return HandleGenesis(doc, appState, m.encodingConfig.Marshaler, m.db)
 }
 
 // HandleBlock implements modules.BlockModule
 func (m *Module) HandleBlock(b *tmctypes.ResultBlock, _ []*types.Tx, vals *tmctypes.ResultValidators) error {
-	return HandleBlock(b.Block.Height, vals, m.govClient, m.bankClient, m.stakingClient, m.encodingConfig.Marshaler, m.db)
+	return HandleBlock(b, vals, m.blockResults, m.govClient, m.bankClient, m.stakingClient, m.encodingConfig.Marshaler, m.db)
 }
 
 // HandleMsg implements modules.MessageModule
@@ -22,6 +22,7 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 const (
@@ -56,7 +57,7 @@ func UpdateProposal(
 		return fmt.Errorf("error while updating proposal status: %s", err)
 	}
 
-	err = updateProposalTallyResult(res.Proposal, govClient, db)
+	tally, err := updateProposalTallyResult(height, res.Proposal, govClient, db)
 	if err != nil {
 		return fmt.Errorf("error while updating proposal tally result: %s", err)
 	}
@@ -66,7 +67,12 @@ func UpdateProposal(
 		return fmt.Errorf("error while updating account: %s", err)
 	}
 
-	err = updateProposalStakingPoolSnapshot(height, id, stakingClient, db)
+	err = updateUpgradePlan(height, res.Proposal, db)
+	if err != nil {
+		return fmt.Errorf("error while updating upgrade plan: %s", err)
+	}
+
+	pool, err := updateProposalStakingPoolSnapshot(height, id, stakingClient, db)
 	if err != nil {
 		return fmt.Errorf("error while updating proposal staking pool snapshot: %s", err)
 	}
@@ -76,6 +82,11 @@ func UpdateProposal(
 		return fmt.Errorf("error while updating proposal validator statuses snapshot: %s", err)
 	}
 
+	err = updateTallyProjection(height, res.Proposal, tally, pool.BondedTokens, govClient, db)
+	if err != nil {
+		return fmt.Errorf("error while updating tally projection: %s", err)
+	}
+
 	return nil
 }
 
@@ -110,23 +121,20 @@ func updateProposalStatus(proposal govtypes.Proposal, db *database.Db) error {
 }
 
 // updateProposalTallyResult updates the tally result associated with the given proposal
-func updateProposalTallyResult(proposal govtypes.Proposal, govClient govtypes.QueryClient, db *database.Db) error {
-	height, err := db.GetLastBlockHeight()
-	if err != nil {
-		return err
-	}
-
-	header := client.GetHeightRequestHeader(height)
+// using the one at the given height, and returns it
+func updateProposalTallyResult(
+	height int64, proposal govtypes.Proposal, govClient govtypes.QueryClient, db *database.Db,
+) (govtypes.TallyResult, error) {
 	res, err := govClient.TallyResult(
 		context.Background(),
 		&govtypes.QueryTallyResultRequest{ProposalId: proposal.ProposalId},
-		header,
+		client.GetHeightRequestHeader(height),
 	)
 	if err != nil {
-		return err
+		return govtypes.TallyResult{}, err
 	}
 
-	return db.SaveTallyResults([]types.TallyResult{
+	err = db.SaveTallyResults([]types.TallyResult{
 		types.NewTallyResult(
 			proposal.ProposalId,
 			res.Tally.Yes.Int64(),
@@ -136,6 +144,7 @@ func updateProposalTallyResult(proposal govtypes.Proposal, govClient govtypes.Qu
 			height,
 		),
 	})
+	return res.Tally, err
 }
 
 // updateAccounts updates any account that might be involved in the proposal (eg. fund community recipient)
@@ -159,19 +168,38 @@ func updateAccounts(proposal govtypes.Proposal, bankClient banktypes.QueryClient
 	return nil
 }
 
+// updateUpgradePlan updates the upgrade plan when the given proposal is a passed software upgrade
+// proposal, or removes it when the proposal is a passed cancel software upgrade proposal
+func updateUpgradePlan(height int64, proposal govtypes.Proposal, db *database.Db) error {
+	if proposal.Status != govtypes.StatusPassed {
+		return nil
+	}
+
+	switch content := proposal.Content.GetCachedValue().(type) {
+	case *upgradetypes.SoftwareUpgradeProposal:
+		return db.SaveUpgradePlan(types.NewUpgradePlan(content.Plan, proposal.ProposalId, height))
+
+	case *upgradetypes.CancelSoftwareUpgradeProposal:
+		return db.DeleteUpgradePlan(height)
+	}
+
+	return nil
+}
+
 // updateProposalStakingPoolSnapshot updates the staking pool snapshot associated with the gov
 // proposal having the provided id
 func updateProposalStakingPoolSnapshot(
 	height int64, proposalID uint64, stakingClient stakingtypes.QueryClient, db *database.Db,
-) error {
+) (*types.Pool, error) {
 	pool, err := stakingutils.GetStakingPool(height, stakingClient)
 	if err != nil {
-		return fmt.Errorf("error while getting staking pool: %s", err)
+		return nil, fmt.Errorf("error while getting staking pool: %s", err)
 	}
 
-	return db.SaveProposalStakingPoolSnapshot(
+	err = db.SaveProposalStakingPoolSnapshot(
 		types.NewProposalStakingPoolSnapshot(proposalID, pool),
 	)
+	return pool, err
 }
 
 // updateProposalValidatorStatusesSnapshot updates the snapshots of the various validators for
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibcclienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// ContentHandler represents a function that stores the typed representation of the content of a proposal.
+// It must return false if it does not know how to handle the given content, so that other handlers can try
+type ContentHandler = func(
+	proposalID uint64, content govtypes.Content, cdc codec.Marshaler, db *database.Db,
+) (handled bool, err error)
+
+var (
+	contentHandlers = []ContentHandler{handleCosmosContent}
+)
+
+// RegisterContentHandler registers the given handler so that it is used to store the contents of the proposals.
+// This allows chain-specific proposal types to be stored inside their own tables.
+// It should be called before starting the parsing, as it happens with govtypes.RegisterProposalType
+func RegisterContentHandler(handler ContentHandler) {
+	contentHandlers = append(contentHandlers, handler)
+}
+
+// SaveProposalContent stores the typed representation of the given proposal content using the first registered
+// handler that is able to handle it. Contents that no handler knows about are only stored inside the proposal table
+func SaveProposalContent(proposalID uint64, content govtypes.Content, cdc codec.Marshaler, db *database.Db) error {
+	for _, handler := range contentHandlers {
+		handled, err := handler(proposalID, content, cdc, db)
+		if err != nil {
+			return fmt.Errorf("error while storing proposal content: %s", err)
+		}
+
+		if handled {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// handleCosmosContent stores the contents of the proposal types that are defined inside the Cosmos SDK
+func handleCosmosContent(
+	proposalID uint64, content govtypes.Content, cdc codec.Marshaler, db *database.Db,
+) (bool, error) {
+	switch proposal := content.(type) {
+	case *paramsproposal.ParameterChangeProposal:
+		changes := make([]types.ProposalParamChange, len(proposal.Changes))
+		for index, change := range proposal.Changes {
+			changes[index] = types.NewProposalParamChange(proposalID, change.Subspace, change.Key, change.Value)
+		}
+		return true, db.SaveProposalParamChanges(changes)
+
+	case *distrtypes.CommunityPoolSpendProposal:
+		err := db.SaveAccounts([]types.Account{types.NewAccount(proposal.Recipient)})
+		if err != nil {
+			return true, err
+		}
+
+		return true, db.SaveProposalCommunityPoolSpend(
+			types.NewProposalCommunityPoolSpend(proposalID, proposal.Recipient, proposal.Amount),
+		)
+
+	case *upgradetypes.SoftwareUpgradeProposal:
+		return true, db.SaveProposalSoftwareUpgrade(
+			types.NewProposalSoftwareUpgrade(proposalID, proposal.Plan.Name, proposal.Plan.Height, proposal.Plan.Info),
+		)
+
+	case *upgradetypes.CancelSoftwareUpgradeProposal:
+		return true, db.SaveProposalCancelSoftwareUpgrade(proposalID)
+
+	case *ibcclienttypes.ClientUpdateProposal:
+		header := []byte("{}")
+		if proposal.Header != nil {
+			bz, err := cdc.MarshalJSON(proposal.Header)
+			if err != nil {
+				return true, fmt.Errorf("error while marshaling client update header: %s", err)
+			}
+			header = bz
+		}
+
+		return true, db.SaveProposalClientUpdate(
+			types.NewProposalClientUpdate(proposalID, proposal.ClientId, header),
+		)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+// EndedProposal contains the data of a proposal that has ended inside the EndBlock of a block
+type EndedProposal struct {
+	ProposalID uint64
+	Result     string
+}
+
+// GetEndedProposals returns all the proposals that have ended based on the given EndBlock events.
+// These include both the proposals that have been dropped due to the deposit period expiring and
+// the ones whose voting period has ended
+func GetEndedProposals(events sdk.StringEvents) []EndedProposal {
+	var proposals []EndedProposal
+	for _, event := range events {
+		if event.Type != govtypes.EventTypeInactiveProposal && event.Type != govtypes.EventTypeActiveProposal {
+			continue
+		}
+
+		for _, record := range utils.SplitEventRecords(event) {
+			proposalID, err := strconv.ParseUint(record[govtypes.AttributeKeyProposalID], 10, 64)
+			if err != nil {
+				continue
+			}
+
+			proposals = append(proposals, EndedProposal{
+				ProposalID: proposalID,
+				Result:     record[govtypes.AttributeKeyProposalResult],
+			})
+		}
+	}
+	return proposals
+}
+
+// GetDepositOutcome returns the outcome of the deposits of the provided ended proposal.
+// Passed proposals (even when failing on execution) always have their deposits refunded, while dropped
+// proposals always have them burned. Rejected proposals have their deposits burned when their final tally
+// has not reached the quorum or has been vetoed, following the same rules used on chain
+func GetDepositOutcome(
+	proposal EndedProposal, params govtypes.TallyParams, tally govtypes.TallyResult, bondedTokens sdk.Int, height int64,
+) types.DepositOutcome {
+	switch proposal.Result {
+	case govtypes.AttributeValueProposalPassed, govtypes.AttributeValueProposalFailed:
+		return types.NewDepositOutcome(proposal.ProposalID, types.DepositOutcomeRefunded, height)
+
+	case govtypes.AttributeValueProposalRejected:
+		projection := GetTallyProjection(proposal.ProposalID, params, tally, bondedTokens, height)
+		burned := (bondedTokens.IsPositive() && !projection.QuorumReached) || projection.Vetoed
+		if !burned {
+			return types.NewDepositOutcome(proposal.ProposalID, types.DepositOutcomeRefunded, height)
+		}
+	}
+
+	return types.NewDepositOutcome(proposal.ProposalID, types.DepositOutcomeBurned, height)
+}
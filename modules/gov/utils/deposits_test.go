@@ -0,0 +1,120 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	govutils "github.com/forbole/bdjuno/modules/gov/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func TestGetEndedProposals(t *testing.T) {
+	events := sdk.StringEvents{
+		{
+			Type: "inactive_proposal",
+			Attributes: []sdk.Attribute{
+				{Key: "proposal_id", Value: "1"},
+				{Key: "proposal_result", Value: "proposal_dropped"},
+			},
+		},
+		{
+			Type: "active_proposal",
+			Attributes: []sdk.Attribute{
+				{Key: "proposal_id", Value: "2"},
+				{Key: "proposal_result", Value: "proposal_passed"},
+				{Key: "proposal_id", Value: "3"},
+				{Key: "proposal_result", Value: "proposal_rejected"},
+			},
+		},
+	}
+
+	require.Equal(t, []govutils.EndedProposal{
+		{ProposalID: 1, Result: govtypes.AttributeValueProposalDropped},
+		{ProposalID: 2, Result: govtypes.AttributeValueProposalPassed},
+		{ProposalID: 3, Result: govtypes.AttributeValueProposalRejected},
+	}, govutils.GetEndedProposals(events))
+}
+
+func TestGetDepositOutcome(t *testing.T) {
+	params := govtypes.NewTallyParams(
+		sdk.NewDecWithPrec(4, 1),   // Quorum
+		sdk.NewDecWithPrec(5, 1),   // Threshold
+		sdk.NewDecWithPrec(334, 3), // Veto threshold
+	)
+
+	tally := func(yes, abstain, no, noWithVeto int64) govtypes.TallyResult {
+		return govtypes.NewTallyResult(
+			sdk.NewInt(yes), sdk.NewInt(abstain), sdk.NewInt(no), sdk.NewInt(noWithVeto),
+		)
+	}
+
+	testCases := []struct {
+		name         string
+		result       string
+		tally        govtypes.TallyResult
+		bondedTokens sdk.Int
+		expected     string
+	}{
+		{
+			name:         "dropped proposal deposits are burned",
+			result:       govtypes.AttributeValueProposalDropped,
+			tally:        tally(0, 0, 0, 0),
+			bondedTokens: sdk.NewInt(1000),
+			expected:     types.DepositOutcomeBurned,
+		},
+		{
+			name:         "passed proposal deposits are refunded",
+			result:       govtypes.AttributeValueProposalPassed,
+			tally:        tally(500, 0, 0, 0),
+			bondedTokens: sdk.NewInt(1000),
+			expected:     types.DepositOutcomeRefunded,
+		},
+		{
+			name:         "failed proposal deposits are refunded",
+			result:       govtypes.AttributeValueProposalFailed,
+			tally:        tally(500, 0, 0, 0),
+			bondedTokens: sdk.NewInt(1000),
+			expected:     types.DepositOutcomeRefunded,
+		},
+		{
+			name:         "rejected proposal deposits without quorum are burned",
+			result:       govtypes.AttributeValueProposalRejected,
+			tally:        tally(100, 0, 100, 0),
+			bondedTokens: sdk.NewInt(1000),
+			expected:     types.DepositOutcomeBurned,
+		},
+		{
+			name:         "vetoed proposal deposits are burned",
+			result:       govtypes.AttributeValueProposalRejected,
+			tally:        tally(200, 0, 100, 200),
+			bondedTokens: sdk.NewInt(1000),
+			expected:     types.DepositOutcomeBurned,
+		},
+		{
+			name:         "rejected proposal deposits with quorum and without veto are refunded",
+			result:       govtypes.AttributeValueProposalRejected,
+			tally:        tally(100, 0, 400, 0),
+			bondedTokens: sdk.NewInt(1000),
+			expected:     types.DepositOutcomeRefunded,
+		},
+		{
+			name:         "rejected proposal deposits without bonded tokens are refunded",
+			result:       govtypes.AttributeValueProposalRejected,
+			tally:        tally(0, 0, 0, 0),
+			bondedTokens: sdk.ZeroInt(),
+			expected:     types.DepositOutcomeRefunded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := govutils.EndedProposal{ProposalID: 1, Result: tc.result}
+			outcome := govutils.GetDepositOutcome(proposal, params, tc.tally, tc.bondedTokens, 20)
+			require.Equal(t, types.NewDepositOutcome(1, tc.expected, 20), outcome)
+		})
+	}
+}
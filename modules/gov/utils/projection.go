@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/desmos-labs/juno/client"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// updateTallyProjection computes and stores the projected outcome of the given proposal using the tally result
+// and bonded tokens at the given height, along with the tally params that were in place at the same height.
+// Proposals that are not in voting period are ignored
+func updateTallyProjection(
+	height int64, proposal govtypes.Proposal, tally govtypes.TallyResult, bondedTokens sdk.Int,
+	govClient govtypes.QueryClient, db *database.Db,
+) error {
+	if proposal.Status != govtypes.StatusVotingPeriod {
+		return nil
+	}
+
+	res, err := govClient.Params(
+		context.Background(),
+		&govtypes.QueryParamsRequest{ParamsType: govtypes.ParamTallying},
+		client.GetHeightRequestHeader(height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while getting tally params: %s", err)
+	}
+
+	return db.SaveTallyProjection(
+		GetTallyProjection(proposal.ProposalId, res.TallyParams, tally, bondedTokens, height),
+	)
+}
+
+// GetTallyProjection returns the outcome that the proposal would have if its voting period ended with the given
+// tally result, following the same rules used on chain
+func GetTallyProjection(
+	proposalID uint64, params govtypes.TallyParams, tally govtypes.TallyResult, bondedTokens sdk.Int, height int64,
+) types.ProposalTallyProjection {
+	yes, no := tally.Yes.ToDec(), tally.No.ToDec()
+	abstain, veto := tally.Abstain.ToDec(), tally.NoWithVeto.ToDec()
+	total := yes.Add(no).Add(abstain).Add(veto)
+	nonAbstaining := total.Sub(abstain)
+
+	turnout, yesRatio, vetoRatio := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	if bondedTokens.IsPositive() {
+		turnout = total.QuoInt(bondedTokens)
+	}
+	if nonAbstaining.IsPositive() {
+		yesRatio = yes.Quo(nonAbstaining)
+	}
+	if total.IsPositive() {
+		vetoRatio = veto.Quo(total)
+	}
+
+	quorumReached := bondedTokens.IsPositive() && turnout.GTE(params.Quorum)
+	vetoed := vetoRatio.GT(params.VetoThreshold)
+	thresholdReached := yesRatio.GT(params.Threshold)
+
+	outcome := govtypes.StatusRejected.String()
+	if quorumReached && nonAbstaining.IsPositive() && !vetoed && thresholdReached {
+		outcome = govtypes.StatusPassed.String()
+	}
+
+	return types.NewProposalTallyProjection(
+		proposalID, turnout, yesRatio, vetoRatio, quorumReached, thresholdReached, vetoed, outcome, height,
+	)
+}
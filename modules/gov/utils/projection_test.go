@@ -0,0 +1,93 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	govutils "github.com/forbole/bdjuno/modules/gov/utils"
+)
+
+func TestGetTallyProjection(t *testing.T) {
+	params := govtypes.NewTallyParams(
+		sdk.NewDecWithPrec(4, 1),   // Quorum
+		sdk.NewDecWithPrec(5, 1),   // Threshold
+		sdk.NewDecWithPrec(334, 3), // Veto threshold
+	)
+
+	tally := func(yes, abstain, no, noWithVeto int64) govtypes.TallyResult {
+		return govtypes.NewTallyResult(
+			sdk.NewInt(yes), sdk.NewInt(abstain), sdk.NewInt(no), sdk.NewInt(noWithVeto),
+		)
+	}
+
+	testCases := []struct {
+		name             string
+		tally            govtypes.TallyResult
+		bondedTokens     sdk.Int
+		quorumReached    bool
+		thresholdReached bool
+		vetoed           bool
+		outcome          string
+	}{
+		{
+			name:         "no bonded tokens is rejected",
+			tally:        tally(0, 0, 0, 0),
+			bondedTokens: sdk.ZeroInt(),
+			outcome:      govtypes.StatusRejected.String(),
+		},
+		{
+			name:             "quorum not reached is rejected",
+			tally:            tally(30, 0, 0, 0),
+			bondedTokens:     sdk.NewInt(100),
+			thresholdReached: true,
+			outcome:          govtypes.StatusRejected.String(),
+		},
+		{
+			name:          "only abstaining votes are rejected",
+			tally:         tally(0, 50, 0, 0),
+			bondedTokens:  sdk.NewInt(100),
+			quorumReached: true,
+			outcome:       govtypes.StatusRejected.String(),
+		},
+		{
+			name:             "vetoed is rejected",
+			tally:            tally(30, 0, 0, 20),
+			bondedTokens:     sdk.NewInt(100),
+			quorumReached:    true,
+			thresholdReached: true,
+			vetoed:           true,
+			outcome:          govtypes.StatusRejected.String(),
+		},
+		{
+			name:          "threshold not reached is rejected",
+			tally:         tally(20, 10, 20, 0),
+			bondedTokens:  sdk.NewInt(100),
+			quorumReached: true,
+			outcome:       govtypes.StatusRejected.String(),
+		},
+		{
+			name:             "threshold reached is passed",
+			tally:            tally(30, 10, 10, 0),
+			bondedTokens:     sdk.NewInt(100),
+			quorumReached:    true,
+			thresholdReached: true,
+			outcome:          govtypes.StatusPassed.String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			projection := govutils.GetTallyProjection(1, params, tc.tally, tc.bondedTokens, 20)
+			require.Equal(t, uint64(1), projection.ProposalID)
+			require.Equal(t, tc.quorumReached, projection.QuorumReached)
+			require.Equal(t, tc.thresholdReached, projection.ThresholdReached)
+			require.Equal(t, tc.vetoed, projection.Vetoed)
+			require.Equal(t, tc.outcome, projection.Outcome)
+			require.Equal(t, int64(20), projection.Height)
+		})
+	}
+}
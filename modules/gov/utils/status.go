@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/forbole/bdjuno/types"
+)
+
+// GetStatus returns the status that the proposal has reached when ending
+func (p EndedProposal) GetStatus() string {
+	switch p.Result {
+	case govtypes.AttributeValueProposalPassed:
+		return govtypes.StatusPassed.String()
+
+	case govtypes.AttributeValueProposalRejected:
+		return govtypes.StatusRejected.String()
+
+	case govtypes.AttributeValueProposalFailed:
+		return govtypes.StatusFailed.String()
+
+	default:
+		return types.ProposalStatusRemoved
+	}
+}
+
+// HasVotingPeriodStarted tells whether the given event signals that the voting period
+// of the proposal having the given id has started
+func HasVotingPeriodStarted(event sdk.StringEvent, proposalID uint64) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key == govtypes.AttributeKeyVotingPeriodStart && attr.Value == strconv.FormatUint(proposalID, 10) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	govutils "github.com/forbole/bdjuno/modules/gov/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func TestEndedProposal_GetStatus(t *testing.T) {
+	require.Equal(t, govtypes.StatusPassed.String(),
+		govutils.EndedProposal{Result: govtypes.AttributeValueProposalPassed}.GetStatus())
+	require.Equal(t, govtypes.StatusRejected.String(),
+		govutils.EndedProposal{Result: govtypes.AttributeValueProposalRejected}.GetStatus())
+	require.Equal(t, govtypes.StatusFailed.String(),
+		govutils.EndedProposal{Result: govtypes.AttributeValueProposalFailed}.GetStatus())
+	require.Equal(t, types.ProposalStatusRemoved,
+		govutils.EndedProposal{Result: govtypes.AttributeValueProposalDropped}.GetStatus())
+}
+
+func TestHasVotingPeriodStarted(t *testing.T) {
+	event := sdk.StringEvent{
+		Type: "proposal_deposit",
+		Attributes: []sdk.Attribute{
+			{Key: "amount", Value: "100uatom"},
+			{Key: "proposal_id", Value: "1"},
+			{Key: "voting_period_start", Value: "1"},
+			{Key: "amount", Value: "100uatom"},
+			{Key: "proposal_id", Value: "2"},
+		},
+	}
+
+	require.True(t, govutils.HasVotingPeriodStarted(event, 1))
+	require.False(t, govutils.HasVotingPeriodStarted(event, 2))
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/bdjuno/database"
+	stakingutils "github.com/forbole/bdjuno/modules/staking/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+// UpdateEffectiveTally computes and stores the breakdown of the tally of the proposal having the given id, using the
+// validators that are bonded at the given height along with their delegations at that height and the stored votes.
+// It should be called when the voting period of the proposal ends
+func UpdateEffectiveTally(
+	height int64, proposalID uint64, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	_, validators, err := stakingutils.GetValidatorsWithStatus(height, stakingtypes.Bonded.String(), stakingClient, cdc)
+	if err != nil {
+		return fmt.Errorf("error while getting bonded validators: %s", err)
+	}
+
+	err = db.SaveValidatorsData(validators)
+	if err != nil {
+		return err
+	}
+
+	var delegations []types.Delegation
+	for _, validator := range validators {
+		validatorDelegations, err := stakingutils.GetValidatorDelegations(height, validator.GetOperator(), stakingClient)
+		if err != nil {
+			return fmt.Errorf("error while getting validator delegations: %s", err)
+		}
+
+		delegations = append(delegations, validatorDelegations...)
+	}
+
+	votes, err := db.GetProposalVotes(proposalID)
+	if err != nil {
+		return err
+	}
+
+	validatorsTallies, delegatorsTallies := GetEffectiveTally(proposalID, validators, delegations, votes, height)
+
+	err = db.SaveProposalValidatorTallies(validatorsTallies)
+	if err != nil {
+		return err
+	}
+
+	accounts := make([]types.Account, len(delegatorsTallies))
+	for index, tally := range delegatorsTallies {
+		accounts[index] = types.NewAccount(tally.DelegatorAddress)
+	}
+
+	err = db.SaveAccounts(accounts)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveProposalDelegatorTallies(delegatorsTallies)
+}
+
+// GetEffectiveTally computes how the given votes have been counted towards the proposal having the given id,
+// using the provided bonded validators and delegations. As it happens on chain, validators vote on behalf of their
+// delegators, unless the delegators have voted themselves: in this case the validator vote is overridden for their
+// stake. Delegations towards validators that are not bonded are ignored, since they do not have any voting power
+func GetEffectiveTally(
+	proposalID uint64,
+	validators []types.Validator, delegations []types.Delegation, votes []types.Vote,
+	height int64,
+) ([]types.ProposalValidatorTally, []types.ProposalDelegatorTally) {
+	options := map[string]string{}
+	for _, vote := range votes {
+		options[vote.Voter] = vote.Option.String()
+	}
+
+	validatorsTallies := make([]types.ProposalValidatorTally, len(validators))
+	validatorsIndexes := map[string]int{}
+	for index, validator := range validators {
+		validatorsIndexes[validator.GetOperator()] = index
+		validatorsTallies[index] = types.NewProposalValidatorTally(
+			proposalID, validator.GetConsAddr(), options[validator.GetSelfDelegateAddress()],
+			sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), height,
+		)
+	}
+
+	var delegatorsTallies []types.ProposalDelegatorTally
+	for _, delegation := range delegations {
+		index, found := validatorsIndexes[delegation.ValidatorOperAddr]
+		if !found {
+			continue
+		}
+
+		amount := delegation.Amount.Amount
+		validatorTally := &validatorsTallies[index]
+		validatorTally.TotalPower = validatorTally.TotalPower.Add(amount)
+
+		delegatorOption := options[delegation.DelegatorAddress]
+		effectiveOption := delegatorOption
+		if delegatorOption != "" {
+			validatorTally.OverriddenPower = validatorTally.OverriddenPower.Add(amount)
+		} else {
+			effectiveOption = validatorTally.Option
+		}
+
+		delegatorsTallies = append(delegatorsTallies, types.NewProposalDelegatorTally(
+			proposalID,
+			delegation.DelegatorAddress,
+			validatorTally.ValidatorAddress,
+			amount,
+			delegatorOption,
+			validatorTally.Option,
+			effectiveOption,
+			height,
+		))
+	}
+
+	for index, tally := range validatorsTallies {
+		if tally.Option != "" {
+			validatorsTallies[index].EffectivePower = tally.TotalPower.Sub(tally.OverriddenPower)
+		}
+	}
+
+	return validatorsTallies, delegatorsTallies
+}
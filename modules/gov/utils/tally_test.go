@@ -0,0 +1,56 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	govutils "github.com/forbole/bdjuno/modules/gov/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func TestGetEffectiveTally(t *testing.T) {
+	rate := sdk.NewDec(1)
+	validators := []types.Validator{
+		types.NewValidator("valcons1", "valoper1", "valconspub1", "validator1", &rate, &rate, 10),
+		types.NewValidator("valcons2", "valoper2", "valconspub2", "validator2", &rate, &rate, 10),
+	}
+
+	// Amounts of 18-decimal denoms do not fit into an int64
+	large, ok := sdk.NewIntFromString("10000000000000000000000000")
+	require.True(t, ok)
+
+	delegation := func(validator, delegator string, amount sdk.Int) types.Delegation {
+		return types.NewDelegation(delegator, validator, sdk.NewCoin("stake", amount), 20)
+	}
+	delegations := []types.Delegation{
+		delegation("valoper1", "validator1", sdk.NewInt(100)),
+		delegation("valoper1", "delegator1", sdk.NewInt(50)),
+		delegation("valoper1", "delegator2", sdk.NewInt(30)),
+		delegation("valoper2", "delegator2", large),
+		delegation("valoper3", "delegator3", sdk.NewInt(1000)), // Not bonded
+	}
+
+	votes := []types.Vote{
+		types.NewVote(1, "validator1", govtypes.OptionYes, 10),
+		types.NewVote(1, "delegator2", govtypes.OptionNo, 10),
+		types.NewVote(1, "delegator3", govtypes.OptionAbstain, 10),
+	}
+
+	validatorsTallies, delegatorsTallies := govutils.GetEffectiveTally(1, validators, delegations, votes, 20)
+
+	yes, no := govtypes.OptionYes.String(), govtypes.OptionNo.String()
+	require.Equal(t, []types.ProposalValidatorTally{
+		types.NewProposalValidatorTally(1, "valcons1", yes, sdk.NewInt(180), sdk.NewInt(130), sdk.NewInt(50), 20),
+		types.NewProposalValidatorTally(1, "valcons2", "", large, large, sdk.ZeroInt(), 20),
+	}, validatorsTallies)
+
+	require.Equal(t, []types.ProposalDelegatorTally{
+		types.NewProposalDelegatorTally(1, "validator1", "valcons1", sdk.NewInt(100), yes, yes, yes, 20),
+		types.NewProposalDelegatorTally(1, "delegator1", "valcons1", sdk.NewInt(50), "", yes, yes, 20),
+		types.NewProposalDelegatorTally(1, "delegator2", "valcons1", sdk.NewInt(30), no, yes, no, 20),
+		types.NewProposalDelegatorTally(1, "delegator2", "valcons2", large, no, "", no, 20),
+	}, delegatorsTallies)
+}
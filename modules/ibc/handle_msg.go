@@ -0,0 +1,393 @@
+package ibc
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/database"
+	ibcutils "github.com/forbole/bdjuno/modules/ibc/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+// HandleMsg allows to handle the different IBC messages, tracking the lifecycle of the ICS-20 transfers
+// as well as the clients, connections and channels of the chain
+func HandleMsg(
+	tx *juno.Tx, index int, msg sdk.Msg, clientClient clienttypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	switch ibcMsg := msg.(type) {
+	case *clienttypes.MsgCreateClient:
+		return handleMsgCreateClient(tx, index, ibcMsg, cdc, db)
+
+	case *clienttypes.MsgUpdateClient:
+		return handleMsgUpdateClient(tx, ibcMsg, clientClient, cdc, db)
+
+	case *clienttypes.MsgSubmitMisbehaviour:
+		return handleMsgSubmitMisbehaviour(tx, ibcMsg, clientClient, cdc, db)
+
+	case *clienttypes.MsgUpgradeClient:
+		return handleClientState(tx, ibcMsg.ClientId, ibcMsg.ClientState, ibcMsg.ConsensusState, cdc, db)
+
+	case *connectiontypes.MsgConnectionOpenInit:
+		return handleConnectionHandshake(tx, index, connectiontypes.EventTypeConnectionOpenInit, connectiontypes.INIT, db)
+
+	case *connectiontypes.MsgConnectionOpenTry:
+		return handleConnectionHandshake(tx, index, connectiontypes.EventTypeConnectionOpenTry, connectiontypes.TRYOPEN, db)
+
+	case *connectiontypes.MsgConnectionOpenAck:
+		return handleConnectionHandshake(tx, index, connectiontypes.EventTypeConnectionOpenAck, connectiontypes.OPEN, db)
+
+	case *connectiontypes.MsgConnectionOpenConfirm:
+		return handleConnectionHandshake(tx, index, connectiontypes.EventTypeConnectionOpenConfirm, connectiontypes.OPEN, db)
+
+	case *channeltypes.MsgChannelOpenInit:
+		return handleChannelHandshake(tx, index, channeltypes.EventTypeChannelOpenInit, channeltypes.INIT, db)
+
+	case *channeltypes.MsgChannelOpenTry:
+		return handleChannelHandshake(tx, index, channeltypes.EventTypeChannelOpenTry, channeltypes.TRYOPEN, db)
+
+	case *channeltypes.MsgChannelOpenAck:
+		return handleChannelHandshake(tx, index, channeltypes.EventTypeChannelOpenAck, channeltypes.OPEN, db)
+
+	case *channeltypes.MsgChannelOpenConfirm:
+		return handleChannelHandshake(tx, index, channeltypes.EventTypeChannelOpenConfirm, channeltypes.OPEN, db)
+
+	case *channeltypes.MsgChannelCloseInit:
+		return handleChannelHandshake(tx, index, channeltypes.EventTypeChannelCloseInit, channeltypes.CLOSED, db)
+
+	case *channeltypes.MsgChannelCloseConfirm:
+		return handleChannelHandshake(tx, index, channeltypes.EventTypeChannelCloseConfirm, channeltypes.CLOSED, db)
+
+	case *transfertypes.MsgTransfer:
+		return handleMsgTransfer(tx, index, ibcMsg, db)
+
+	case *channeltypes.MsgRecvPacket:
+		return handleMsgRecvPacket(tx, index, ibcMsg, db)
+
+	case *channeltypes.MsgAcknowledgement:
+		return handleMsgAcknowledgement(tx, ibcMsg, db)
+
+	case *channeltypes.MsgTimeout:
+		return handlePacketTimeout(tx, ibcMsg.Packet, db)
+
+	case *channeltypes.MsgTimeoutOnClose:
+		return handlePacketTimeout(tx, ibcMsg.Packet, db)
+	}
+
+	return nil
+}
+
+// handleMsgTransfer handles a MsgTransfer storing the new outgoing transfer
+func handleMsgTransfer(tx *juno.Tx, index int, msg *transfertypes.MsgTransfer, db *database.Db) error {
+	event, err := tx.FindEventByType(index, channeltypes.EventTypeSendPacket)
+	if err != nil {
+		return err
+	}
+
+	sequenceStr, err := tx.FindAttributeByKey(event, channeltypes.AttributeKeySequence)
+	if err != nil {
+		return err
+	}
+
+	sequence, err := strconv.ParseUint(sequenceStr, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	dstPort, err := tx.FindAttributeByKey(event, channeltypes.AttributeKeyDstPort)
+	if err != nil {
+		return err
+	}
+
+	dstChannel, err := tx.FindAttributeByKey(event, channeltypes.AttributeKeyDstChannel)
+	if err != nil {
+		return err
+	}
+
+	transfer := types.NewIBCTransfer(
+		types.IBCTransferDirectionOutgoing,
+		msg.SourcePort,
+		msg.SourceChannel,
+		dstPort,
+		dstChannel,
+		sequence,
+		msg.Sender,
+		msg.Receiver,
+		msg.Token.Denom,
+		msg.Token.Amount.String(),
+		types.IBCTransferStatusPending,
+		"",
+		tx.Height,
+	)
+	return db.SaveIBCTransfer(transfer, tx.TxHash)
+}
+
+// handleMsgRecvPacket handles a MsgRecvPacket storing the received transfer, if any
+func handleMsgRecvPacket(tx *juno.Tx, index int, msg *channeltypes.MsgRecvPacket, db *database.Db) error {
+	if msg.Packet.DestinationPort != transfertypes.PortID {
+		return nil
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	err := transfertypes.ModuleCdc.UnmarshalJSON(msg.Packet.GetData(), &data)
+	if err != nil {
+		return err
+	}
+
+	// The packet might have been processed without success, in which case an error acknowledgement is written
+	status, ackError := types.IBCTransferStatusReceived, ""
+	event, err := tx.FindEventByType(index, channeltypes.EventTypeWriteAck)
+	if err == nil {
+		ackValue, err := tx.FindAttributeByKey(event, channeltypes.AttributeKeyAck)
+		if err != nil {
+			return err
+		}
+
+		var ack channeltypes.Acknowledgement
+		err = transfertypes.ModuleCdc.UnmarshalJSON([]byte(ackValue), &ack)
+		if err != nil {
+			return err
+		}
+
+		if ack.GetError() != "" {
+			status, ackError = types.IBCTransferStatusError, ack.GetError()
+		}
+	}
+
+	// Store the trace of the received denom, so that it is known without having to query it
+	trace := ibcutils.GetReceivedDenomTrace(msg.Packet, data.Denom)
+	if trace.Path != "" {
+		err = db.SaveIBCDenomTraces([]types.IBCDenomTrace{ibcutils.ConvertDenomTrace(trace)})
+		if err != nil {
+			return err
+		}
+	}
+
+	transfer := types.NewIBCTransfer(
+		types.IBCTransferDirectionIncoming,
+		msg.Packet.DestinationPort,
+		msg.Packet.DestinationChannel,
+		msg.Packet.SourcePort,
+		msg.Packet.SourceChannel,
+		msg.Packet.Sequence,
+		data.Sender,
+		data.Receiver,
+		trace.IBCDenom(),
+		strconv.FormatUint(data.Amount, 10),
+		status,
+		ackError,
+		tx.Height,
+	)
+	return db.SaveIBCTransfer(transfer, tx.TxHash)
+}
+
+// handleMsgAcknowledgement handles a MsgAcknowledgement updating the status of the acknowledged transfer
+func handleMsgAcknowledgement(tx *juno.Tx, msg *channeltypes.MsgAcknowledgement, db *database.Db) error {
+	if msg.Packet.SourcePort != transfertypes.PortID {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	err := transfertypes.ModuleCdc.UnmarshalJSON(msg.Acknowledgement, &ack)
+	if err != nil {
+		return err
+	}
+
+	status := types.IBCTransferStatusAcknowledged
+	if ack.GetError() != "" {
+		status = types.IBCTransferStatusError
+	}
+
+	transfer, err := getOutgoingTransfer(msg.Packet, status, ack.GetError(), tx.Height)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveIBCTransfer(transfer, tx.TxHash)
+}
+
+// handlePacketTimeout handles the timeout of the given packet updating the status of the associated transfer
+func handlePacketTimeout(tx *juno.Tx, packet channeltypes.Packet, db *database.Db) error {
+	if packet.SourcePort != transfertypes.PortID {
+		return nil
+	}
+
+	transfer, err := getOutgoingTransfer(packet, types.IBCTransferStatusTimeout, "", tx.Height)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveIBCTransfer(transfer, tx.TxHash)
+}
+
+// getOutgoingTransfer builds the outgoing transfer associated with the given packet
+func getOutgoingTransfer(
+	packet channeltypes.Packet, status, error string, height int64,
+) (types.IBCTransfer, error) {
+	var data transfertypes.FungibleTokenPacketData
+	err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data)
+	if err != nil {
+		return types.IBCTransfer{}, err
+	}
+
+	return types.NewIBCTransfer(
+		types.IBCTransferDirectionOutgoing,
+		packet.SourcePort,
+		packet.SourceChannel,
+		packet.DestinationPort,
+		packet.DestinationChannel,
+		packet.Sequence,
+		data.Sender,
+		data.Receiver,
+		ibcutils.GetSentDenom(data.Denom),
+		strconv.FormatUint(data.Amount, 10),
+		status,
+		error,
+		height,
+	), nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// handleMsgCreateClient handles a MsgCreateClient storing the newly created client
+func handleMsgCreateClient(
+	tx *juno.Tx, index int, msg *clienttypes.MsgCreateClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	event, err := tx.FindEventByType(index, clienttypes.EventTypeCreateClient)
+	if err != nil {
+		return err
+	}
+
+	clientID, err := tx.FindAttributeByKey(event, clienttypes.AttributeKeyClientID)
+	if err != nil {
+		return err
+	}
+
+	return handleClientState(tx, clientID, msg.ClientState, msg.ConsensusState, cdc, db)
+}
+
+// handleClientState stores the client having the given id, built using the provided client and consensus states
+func handleClientState(
+	tx *juno.Tx, clientID string, clientState, consensusState *codectypes.Any, cdc codec.Marshaler, db *database.Db,
+) error {
+	ibcClient, err := ibcutils.ConvertClient(cdc, clientID, clientState, consensusState, tx.Height)
+	if err != nil {
+		return err
+	}
+
+	if ibcClient == nil {
+		return nil
+	}
+
+	return db.SaveIBCClient(*ibcClient)
+}
+
+// handleMsgUpdateClient handles a MsgUpdateClient updating the latest header tracked by the client.
+// Clients that are not stored yet (eg. created before the start of the parsing) are queried from the chain
+func handleMsgUpdateClient(
+	tx *juno.Tx, msg *clienttypes.MsgUpdateClient,
+	clientClient clienttypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	found, err := db.HasIBCClient(msg.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ibcutils.UpdateIBCClient(tx.Height, msg.ClientId, clientClient, cdc, db)
+	}
+
+	update, err := ibcutils.ConvertClientUpdate(cdc, msg.ClientId, msg.Header, tx.Height)
+	if err != nil {
+		return err
+	}
+
+	if update == nil {
+		return nil
+	}
+
+	return db.UpdateIBCClient(*update)
+}
+
+// handleMsgSubmitMisbehaviour handles a MsgSubmitMisbehaviour marking the client as frozen.
+// Clients that are not stored yet are queried from the chain, which already reports them as frozen
+func handleMsgSubmitMisbehaviour(
+	tx *juno.Tx, msg *clienttypes.MsgSubmitMisbehaviour,
+	clientClient clienttypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	found, err := db.HasIBCClient(msg.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ibcutils.UpdateIBCClient(tx.Height, msg.ClientId, clientClient, cdc, db)
+	}
+
+	return db.FreezeIBCClient(msg.ClientId, tx.Height)
+}
+
+// handleConnectionHandshake handles a connection handshake message, storing the connection
+// described by the event having the given type with the provided state
+func handleConnectionHandshake(
+	tx *juno.Tx, index int, eventType string, state connectiontypes.State, db *database.Db,
+) error {
+	event, err := tx.FindEventByType(index, eventType)
+	if err != nil {
+		return err
+	}
+
+	attributes := getEventAttributes(event)
+	connection := types.NewIBCConnection(
+		attributes[connectiontypes.AttributeKeyConnectionID],
+		attributes[connectiontypes.AttributeKeyClientID],
+		state.String(),
+		attributes[connectiontypes.AttributeKeyCounterpartyClientID],
+		attributes[connectiontypes.AttributeKeyCounterpartyConnectionID],
+		tx.Height,
+	)
+	return db.SaveIBCConnection(connection)
+}
+
+// handleChannelHandshake handles a channel handshake message, storing the channel
+// described by the event having the given type with the provided state
+func handleChannelHandshake(
+	tx *juno.Tx, index int, eventType string, state channeltypes.State, db *database.Db,
+) error {
+	event, err := tx.FindEventByType(index, eventType)
+	if err != nil {
+		return err
+	}
+
+	attributes := getEventAttributes(event)
+	channel := types.NewIBCChannel(
+		attributes[channeltypes.AttributeKeyPortID],
+		attributes[channeltypes.AttributeKeyChannelID],
+		state.String(),
+		attributes[channeltypes.AttributeKeyConnectionID],
+		attributes[channeltypes.AttributeCounterpartyPortID],
+		attributes[channeltypes.AttributeCounterpartyChannelID],
+		tx.Height,
+	)
+	return db.SaveIBCChannel(channel)
+}
+
+// getEventAttributes returns the attributes of the given event as a map
+func getEventAttributes(event sdk.StringEvent) map[string]string {
+	attributes := map[string]string{}
+	for _, attr := range event.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	return attributes
+}
@@ -0,0 +1,62 @@
+package ibc
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	ibcutils "github.com/forbole/bdjuno/modules/ibc/utils"
+	"github.com/forbole/bdjuno/modules/utils"
+)
+
+// RegisterPeriodicOps registers the additional utils that periodically run
+func RegisterPeriodicOps(
+	scheduler *gocron.Scheduler,
+	transferClient transfertypes.QueryClient,
+	clientClient clienttypes.QueryClient,
+	connectionClient connectiontypes.QueryClient,
+	channelClient channeltypes.QueryClient,
+	cdc codec.Marshaler,
+	db *database.Db,
+) error {
+	log.Debug().Str("module", "ibc").Msg("setting up periodic tasks")
+
+	// Resolve the new IBC denoms every 10 minutes
+	if _, err := scheduler.Every(10).Minutes().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return ibcutils.UpdateIBCDenomTraces(transferClient, db) })
+	}); err != nil {
+		return err
+	}
+
+	// Update the clients, connections and channels every 1 hour
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error {
+			return updateIBCRegistry(clientClient, connectionClient, channelClient, cdc, db)
+		})
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateIBCRegistry gets the latest clients, connections and channels from the chain and stores them inside the database
+func updateIBCRegistry(
+	clientClient clienttypes.QueryClient,
+	connectionClient connectiontypes.QueryClient,
+	channelClient channeltypes.QueryClient,
+	cdc codec.Marshaler,
+	db *database.Db,
+) error {
+	height, err := db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	return ibcutils.UpdateIBCRegistry(height, clientClient, connectionClient, channelClient, cdc, db)
+}
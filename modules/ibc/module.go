@@ -0,0 +1,67 @@
+package ibc
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/desmos-labs/juno/modules"
+	"github.com/desmos-labs/juno/types"
+	"github.com/go-co-op/gocron"
+
+	"github.com/forbole/bdjuno/database"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the x/ibc module
+type Module struct {
+	encodingConfig   *params.EncodingConfig
+	transferClient   transfertypes.QueryClient
+	clientClient     clienttypes.QueryClient
+	connectionClient connectiontypes.QueryClient
+	channelClient    channeltypes.QueryClient
+	db               *database.Db
+}
+
+// NewModule returns a new Module instance
+func NewModule(
+	transferClient transfertypes.QueryClient,
+	clientClient clienttypes.QueryClient,
+	connectionClient connectiontypes.QueryClient,
+	channelClient channeltypes.QueryClient,
+	encodingConfig *params.EncodingConfig,
+	db *database.Db,
+) *Module {
+	return &Module{
+		encodingConfig:   encodingConfig,
+		transferClient:   transferClient,
+		clientClient:     clientClient,
+		connectionClient: connectionClient,
+		channelClient:    channelClient,
+		db:               db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "ibc"
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *types.Tx) error {
+	return HandleMsg(tx, index, msg, m.clientClient, m.encodingConfig.Marshaler, m.db)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return RegisterPeriodicOps(
+		scheduler, m.transferClient, m.clientClient, m.connectionClient, m.channelClient, m.encodingConfig.Marshaler, m.db,
+	)
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// UpdateIBCDenomTraces resolves all the IBC denoms present inside the database whose trace is not known yet,
+// and stores their traces
+func UpdateIBCDenomTraces(transferClient transfertypes.QueryClient, db *database.Db) error {
+	log.Debug().Str("module", "ibc").Str("operation", "denom traces").Msg("updating denom traces")
+
+	denoms, err := db.GetUnresolvedIBCDenoms()
+	if err != nil {
+		return err
+	}
+
+	var traces []types.IBCDenomTrace
+	for _, denom := range denoms {
+		res, err := transferClient.DenomTrace(
+			context.Background(),
+			&transfertypes.QueryDenomTraceRequest{Hash: strings.TrimPrefix(denom, "ibc/")},
+		)
+		if err != nil {
+			log.Error().Str("module", "ibc").Str("denom", denom).Err(err).Msg("error while getting denom trace")
+			continue
+		}
+
+		traces = append(traces, ConvertDenomTrace(*res.DenomTrace))
+	}
+
+	return db.SaveIBCDenomTraces(traces)
+}
+
+// ConvertDenomTrace converts the given trace into an IBCDenomTrace instance
+func ConvertDenomTrace(trace transfertypes.DenomTrace) types.IBCDenomTrace {
+	return types.NewIBCDenomTrace(trace.IBCDenom(), trace.Path, trace.BaseDenom)
+}
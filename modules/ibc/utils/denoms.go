@@ -0,0 +1,26 @@
+package utils
+
+import (
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// GetSentDenom returns the denom that has been used on this chain to send the coins
+// identified by the given packet denom, which contains the full denom trace
+func GetSentDenom(packetDenom string) string {
+	return transfertypes.ParseDenomTrace(packetDenom).IBCDenom()
+}
+
+// GetReceivedDenomTrace returns the trace of the denom of the coins that have been
+// received on this chain using the given packet
+func GetReceivedDenomTrace(packet channeltypes.Packet, packetDenom string) transfertypes.DenomTrace {
+	// The tokens are coming back to this chain, so the trace is unwound
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, packetDenom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		return transfertypes.ParseDenomTrace(packetDenom[len(voucherPrefix):])
+	}
+
+	// The tokens are vouchers minted on this chain, so the destination is prefixed to the trace
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, packetDenom)
+	return transfertypes.ParseDenomTrace(prefixedDenom)
+}
@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	ibcutils "github.com/forbole/bdjuno/modules/ibc/utils"
+)
+
+func TestGetSentDenom(t *testing.T) {
+	require.Equal(t, "udaric", ibcutils.GetSentDenom("udaric"))
+	require.Equal(t,
+		transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+		ibcutils.GetSentDenom("transfer/channel-0/uatom"),
+	)
+}
+
+func TestGetReceivedDenomTrace(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-5",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+
+	// Vouchers minted on this chain
+	trace := ibcutils.GetReceivedDenomTrace(packet, "uatom")
+	require.Equal(t, "transfer/channel-0", trace.Path)
+	require.Equal(t, "uatom", trace.BaseDenom)
+
+	// Tokens coming back to this chain
+	trace = ibcutils.GetReceivedDenomTrace(packet, "transfer/channel-5/udaric")
+	require.Equal(t, "", trace.Path)
+	require.Equal(t, "udaric", trace.IBCDenom())
+}
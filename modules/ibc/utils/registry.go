@@ -0,0 +1,312 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/07-tendermint/types"
+	"github.com/desmos-labs/juno/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// ConvertClient converts the given client and consensus states into an IBCClient instance.
+// Only Tendermint light clients have a trusting period, so nil is returned for any other client type
+func ConvertClient(
+	cdc codec.Marshaler, clientID string, clientStateAny, consensusStateAny *codectypes.Any, height int64,
+) (*types.IBCClient, error) {
+	var clientState exported.ClientState
+	err := cdc.UnpackAny(clientStateAny, &clientState)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking client state: %s", err)
+	}
+
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, nil
+	}
+
+	var consensusState exported.ConsensusState
+	err = cdc.UnpackAny(consensusStateAny, &consensusState)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking consensus state: %s", err)
+	}
+
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return nil, nil
+	}
+
+	ibcClient := types.NewIBCClient(
+		clientID,
+		tmClientState.ClientType(),
+		tmClientState.ChainId,
+		tmClientState.TrustingPeriod,
+		tmClientState.LatestHeight.RevisionHeight,
+		tmConsensusState.Timestamp.UTC(),
+		tmClientState.IsFrozen(),
+		height,
+	)
+	return &ibcClient, nil
+}
+
+// ConvertClientUpdate converts the given header into an IBCClientUpdate instance.
+// Only Tendermint headers are supported, so nil is returned for any other header type
+func ConvertClientUpdate(
+	cdc codec.Marshaler, clientID string, headerAny *codectypes.Any, height int64,
+) (*types.IBCClientUpdate, error) {
+	var header exported.Header
+	err := cdc.UnpackAny(headerAny, &header)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking client header: %s", err)
+	}
+
+	tmHeader, ok := header.(*ibctmtypes.Header)
+	if !ok {
+		return nil, nil
+	}
+
+	update := types.NewIBCClientUpdate(
+		clientID,
+		tmHeader.GetHeight().GetRevisionHeight(),
+		tmHeader.GetTime().UTC(),
+		height,
+	)
+	return &update, nil
+}
+
+// ConvertConnection converts the given connection into an IBCConnection instance
+func ConvertConnection(connection connectiontypes.IdentifiedConnection, height int64) types.IBCConnection {
+	return types.NewIBCConnection(
+		connection.Id,
+		connection.ClientId,
+		connection.State.String(),
+		connection.Counterparty.ClientId,
+		connection.Counterparty.ConnectionId,
+		height,
+	)
+}
+
+// ConvertChannel converts the given channel into an IBCChannel instance
+func ConvertChannel(channel channeltypes.IdentifiedChannel, height int64) types.IBCChannel {
+	var connectionID string
+	if len(channel.ConnectionHops) > 0 {
+		connectionID = channel.ConnectionHops[0]
+	}
+
+	return types.NewIBCChannel(
+		channel.PortId,
+		channel.ChannelId,
+		channel.State.String(),
+		connectionID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		height,
+	)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// UpdateIBCRegistry queries all the IBC clients, connections and channels present at the given height
+// and stores them inside the database
+func UpdateIBCRegistry(
+	height int64,
+	clientClient clienttypes.QueryClient,
+	connectionClient connectiontypes.QueryClient,
+	channelClient channeltypes.QueryClient,
+	cdc codec.Marshaler,
+	db *database.Db,
+) error {
+	log.Debug().Str("module", "ibc").Int64("height", height).
+		Str("operation", "registry").Msg("updating clients, connections and channels")
+
+	err := updateClients(height, clientClient, cdc, db)
+	if err != nil {
+		return err
+	}
+
+	err = updateConnections(height, connectionClient, db)
+	if err != nil {
+		return err
+	}
+
+	return updateChannels(height, channelClient, db)
+}
+
+// updateClients queries all the IBC clients present at the given height and stores them
+func updateClients(height int64, clientClient clienttypes.QueryClient, cdc codec.Marshaler, db *database.Db) error {
+	header := client.GetHeightRequestHeader(height)
+
+	var clientStates []clienttypes.IdentifiedClientState
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := clientClient.ClientStates(
+			context.Background(),
+			&clienttypes.QueryClientStatesRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 clients at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return fmt.Errorf("error while getting IBC clients: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		clientStates = append(clientStates, res.ClientStates...)
+	}
+
+	for _, clientState := range clientStates {
+		consensusState, err := getLatestConsensusState(height, clientState.ClientId, clientClient)
+		if err != nil {
+			log.Error().Str("module", "ibc").Str("client", clientState.ClientId).Err(err).
+				Msg("error while getting client consensus state")
+			continue
+		}
+
+		ibcClient, err := ConvertClient(cdc, clientState.ClientId, clientState.ClientState, consensusState, height)
+		if err != nil {
+			return err
+		}
+
+		if ibcClient == nil {
+			continue
+		}
+
+		err = db.SaveIBCClient(*ibcClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateIBCClient queries the state of the IBC client having the given id at the provided height and stores it
+func UpdateIBCClient(
+	height int64, clientID string, clientClient clienttypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	res, err := clientClient.ClientState(
+		context.Background(),
+		&clienttypes.QueryClientStateRequest{ClientId: clientID},
+		client.GetHeightRequestHeader(height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while getting IBC client: %s", err)
+	}
+
+	consensusState, err := getLatestConsensusState(height, clientID, clientClient)
+	if err != nil {
+		return fmt.Errorf("error while getting client consensus state: %s", err)
+	}
+
+	ibcClient, err := ConvertClient(cdc, clientID, res.ClientState, consensusState, height)
+	if err != nil {
+		return err
+	}
+
+	if ibcClient == nil {
+		return nil
+	}
+
+	return db.SaveIBCClient(*ibcClient)
+}
+
+// getLatestConsensusState returns the latest consensus state of the client having the given id at the provided height
+func getLatestConsensusState(
+	height int64, clientID string, clientClient clienttypes.QueryClient,
+) (*codectypes.Any, error) {
+	res, err := clientClient.ConsensusState(
+		context.Background(),
+		&clienttypes.QueryConsensusStateRequest{ClientId: clientID, LatestHeight: true},
+		client.GetHeightRequestHeader(height),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ConsensusState, nil
+}
+
+// updateConnections queries all the IBC connections present at the given height and stores them
+func updateConnections(height int64, connectionClient connectiontypes.QueryClient, db *database.Db) error {
+	header := client.GetHeightRequestHeader(height)
+
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := connectionClient.Connections(
+			context.Background(),
+			&connectiontypes.QueryConnectionsRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 connections at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return fmt.Errorf("error while getting IBC connections: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+
+		for _, connection := range res.Connections {
+			err = db.SaveIBCConnection(ConvertConnection(*connection, height))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateChannels queries all the IBC channels present at the given height and stores them
+func updateChannels(height int64, channelClient channeltypes.QueryClient, db *database.Db) error {
+	header := client.GetHeightRequestHeader(height)
+
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := channelClient.Channels(
+			context.Background(),
+			&channeltypes.QueryChannelsRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 channels at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return fmt.Errorf("error while getting IBC channels: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+
+		for _, channel := range res.Channels {
+			err = db.SaveIBCChannel(ConvertChannel(*channel, height))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
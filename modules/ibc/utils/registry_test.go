@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	ibcutils "github.com/forbole/bdjuno/modules/ibc/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+func getCodec() codec.Marshaler {
+	registry := codectypes.NewInterfaceRegistry()
+	clienttypes.RegisterInterfaces(registry)
+	ibctmtypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func TestConvertClient(t *testing.T) {
+	timestamp := time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	clientState, err := clienttypes.PackClientState(&ibctmtypes.ClientState{
+		ChainId:        "cosmoshub-4",
+		TrustingPeriod: 14 * 24 * time.Hour,
+		LatestHeight:   clienttypes.NewHeight(4, 100),
+	})
+	require.NoError(t, err)
+
+	consensusState, err := clienttypes.PackConsensusState(
+		ibctmtypes.NewConsensusState(timestamp, commitmenttypes.NewMerkleRoot([]byte("root")), nil),
+	)
+	require.NoError(t, err)
+
+	ibcClient, err := ibcutils.ConvertClient(getCodec(), "07-tendermint-0", clientState, consensusState, 10)
+	require.NoError(t, err)
+
+	expected := types.NewIBCClient(
+		"07-tendermint-0",
+		"07-tendermint",
+		"cosmoshub-4",
+		14*24*time.Hour,
+		100,
+		timestamp,
+		false,
+		10,
+	)
+	require.Equal(t, &expected, ibcClient)
+	require.Equal(t, time.Date(2021, 1, 15, 12, 00, 00, 000, time.UTC), ibcClient.ExpiryTime())
+}
+
+func TestConvertClientUpdate(t *testing.T) {
+	timestamp := time.Date(2021, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	header, err := clienttypes.PackHeader(&ibctmtypes.Header{
+		SignedHeader: &tmproto.SignedHeader{
+			Header: &tmproto.Header{ChainID: "cosmoshub-4", Height: 150, Time: timestamp},
+		},
+	})
+	require.NoError(t, err)
+
+	update, err := ibcutils.ConvertClientUpdate(getCodec(), "07-tendermint-0", header, 10)
+	require.NoError(t, err)
+
+	expected := types.NewIBCClientUpdate("07-tendermint-0", 150, timestamp, 10)
+	require.Equal(t, &expected, update)
+}
@@ -1,12 +1,16 @@
 package pricefeed
 
 import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/go-co-op/gocron"
 	"github.com/rs/zerolog/log"
 
 	"github.com/forbole/bdjuno/database"
 	"github.com/forbole/bdjuno/modules/pricefeed/coingecko"
 	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types"
 )
 
 // RegisterPeriodicOps returns the AdditionalOperation that periodically runs fetches from
@@ -65,6 +69,56 @@ func updatePrice(db *database.Db) error {
 		return err
 	}
 
+	// Compute the market cap of the tokens for which it is not provided
+	for index, price := range prices {
+		if price.MarketCap != 0 {
+			continue
+		}
+
+		marketCap, err := computeMarketCap(price, db)
+		if err != nil {
+			return err
+		}
+		prices[index].MarketCap = marketCap
+	}
+
 	// Save the token prices
 	return db.SaveTokensPrices(prices)
 }
+
+// computeMarketCap computes the market cap of the token having the given price
+// using the circulating supply that is stored inside the database
+func computeMarketCap(price types.TokenPrice, db *database.Db) (int64, error) {
+	circulatingSupply, err := db.GetCirculatingSupply()
+	if err != nil {
+		return 0, err
+	}
+
+	if circulatingSupply == nil {
+		return 0, nil
+	}
+
+	units, err := db.GetTokenUnitsOf(price.UnitName)
+	if err != nil {
+		return 0, err
+	}
+
+	var baseDenom string
+	var exponent uint32
+	for _, unit := range units {
+		if unit.Exponent == 0 {
+			baseDenom = unit.Denom
+		}
+		if unit.Denom == price.UnitName {
+			exponent = unit.Exponent
+		}
+	}
+
+	tokenPrice, err := sdk.NewDecFromStr(strconv.FormatFloat(price.Price, 'f', sdk.Precision, 64))
+	if err != nil {
+		return 0, err
+	}
+
+	amount := circulatingSupply.AmountOf(baseDenom).ToDec().Quo(sdk.NewDec(10).Power(uint64(exponent)))
+	return amount.Mul(tokenPrice).TruncateInt64(), nil
+}
@@ -0,0 +1,49 @@
+package profiles
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/rs/zerolog/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/bdjuno/database"
+	profilesutils "github.com/forbole/bdjuno/modules/profiles/utils"
+)
+
+// HandleGenesis handles the genesis state of the x/profiles module, storing the genesis profiles
+// along with the DTag transfer requests, relationships and blocks
+func HandleGenesis(
+	doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage, cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "profiles").Msg("parsing genesis")
+
+	// Profiles are stored as accounts inside the x/auth genesis state
+	profiles, err := profilesutils.GetGenesisProfiles(appState, cdc, doc.InitialHeight)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveProfiles(profiles)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis profiles: %s", err)
+	}
+
+	var genState profilestypes.GenesisState
+	err = cdc.UnmarshalJSON(appState[profilestypes.ModuleName], &genState)
+	if err != nil {
+		return err
+	}
+
+	data := profilesutils.ConvertProfilesData(
+		doc.InitialHeight, genState.DTagTransferRequests, genState.Relationships, genState.Blocks,
+	)
+	err = profilesutils.SaveProfilesData(data, db)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis profiles data: %s", err)
+	}
+
+	return nil
+}
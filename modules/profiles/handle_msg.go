@@ -0,0 +1,111 @@
+package profiles
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/database"
+	profilesutils "github.com/forbole/bdjuno/modules/profiles/utils"
+	"github.com/forbole/bdjuno/types"
+)
+
+// HandleMsg allows to handle the different x/profiles messages, keeping the stored
+// profiles, DTag transfer requests, relationships and blocks up to date
+func HandleMsg(
+	tx *juno.Tx, index int, msg sdk.Msg, profilesClient profilestypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	switch profilesMsg := msg.(type) {
+	case *profilestypes.MsgSaveProfile:
+		// The message might contain placeholders for the fields that should not be changed,
+		// so we read the updated profile from the chain instead
+		return profilesutils.RefreshProfile(tx.Height, profilesMsg.Creator, profilesClient, cdc, db)
+
+	case *profilestypes.MsgDeleteProfile:
+		return db.DeleteProfile(profilesMsg.Creator, tx.Height)
+
+	case *profilestypes.MsgRequestDTagTransfer:
+		return handleMsgRequestDTagTransfer(tx, index, profilesMsg, db)
+
+	case *profilestypes.MsgCancelDTagTransferRequest:
+		return db.DeleteDTagTransferRequest(profilesMsg.Sender, profilesMsg.Receiver, tx.Height)
+
+	case *profilestypes.MsgRefuseDTagTransferRequest:
+		return db.DeleteDTagTransferRequest(profilesMsg.Sender, profilesMsg.Receiver, tx.Height)
+
+	case *profilestypes.MsgAcceptDTagTransferRequest:
+		return handleMsgAcceptDTagTransferRequest(tx, profilesMsg, profilesClient, cdc, db)
+
+	case *profilestypes.MsgCreateRelationship:
+		return db.SaveRelationships([]types.Relationship{
+			types.NewRelationship(
+				profilestypes.NewRelationship(profilesMsg.Sender, profilesMsg.Receiver, profilesMsg.Subspace),
+				tx.Height,
+			),
+		})
+
+	case *profilestypes.MsgDeleteRelationship:
+		return db.DeleteRelationship(profilesMsg.User, profilesMsg.Counterparty, profilesMsg.Subspace, tx.Height)
+
+	case *profilestypes.MsgBlockUser:
+		return db.SaveUserBlocks([]types.UserBlock{
+			types.NewUserBlock(
+				profilestypes.NewUserBlock(profilesMsg.Blocker, profilesMsg.Blocked, profilesMsg.Reason, profilesMsg.Subspace),
+				tx.Height,
+			),
+		})
+
+	case *profilestypes.MsgUnblockUser:
+		return db.DeleteUserBlock(profilesMsg.Blocker, profilesMsg.Blocked, profilesMsg.Subspace, tx.Height)
+	}
+
+	return nil
+}
+
+// handleMsgRequestDTagTransfer stores the DTag transfer request created by the given message
+func handleMsgRequestDTagTransfer(
+	tx *juno.Tx, index int, msg *profilestypes.MsgRequestDTagTransfer, db *database.Db,
+) error {
+	event, err := tx.FindEventByType(index, profilestypes.EventTypeDTagTransferRequest)
+	if err != nil {
+		return err
+	}
+
+	dTagToTrade, err := tx.FindAttributeByKey(event, profilestypes.AttributeDTagToTrade)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveDTagTransferRequests([]types.DTagTransferRequest{
+		types.NewDTagTransferRequest(
+			profilestypes.NewDTagTransferRequest(dTagToTrade, msg.Sender, msg.Receiver),
+			tx.Height,
+		),
+	})
+}
+
+// handleMsgAcceptDTagTransferRequest updates the profiles that have exchanged their DTags,
+// removing all the requests made towards them as it happens on chain
+func handleMsgAcceptDTagTransferRequest(
+	tx *juno.Tx, msg *profilestypes.MsgAcceptDTagTransferRequest,
+	profilesClient profilestypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	for _, address := range []string{msg.Receiver, msg.Sender} {
+		err := profilesutils.RefreshProfile(tx.Height, address, profilesClient, cdc, db)
+		if err != nil {
+			return err
+		}
+
+		err = db.DeleteIncomingDTagTransferRequests(address, tx.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package profiles
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	profilesutils "github.com/forbole/bdjuno/modules/profiles/utils"
+	"github.com/forbole/bdjuno/modules/utils"
+)
+
+// RegisterPeriodicOps registers the additional utils that periodically run
+func RegisterPeriodicOps(
+	scheduler *gocron.Scheduler,
+	authClient authtypes.QueryClient, profilesClient profilestypes.QueryClient,
+	cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "profiles").Msg("setting up periodic tasks")
+
+	// Reconcile the stored profiles with the chain every 1 hour
+	if _, err := scheduler.Every(1).Hour().StartImmediately().Do(func() {
+		utils.WatchMethod(func() error { return updateProfiles(authClient, profilesClient, cdc, db) })
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateProfiles reconciles the stored profiles with the ones present on chain at the latest height
+func updateProfiles(
+	authClient authtypes.QueryClient, profilesClient profilestypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	height, err := db.GetLastBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	return profilesutils.UpdateProfiles(height, authClient, profilesClient, cdc, db)
+}
@@ -0,0 +1,71 @@
+package profiles
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/desmos-labs/juno/modules"
+	juno "github.com/desmos-labs/juno/types"
+	"github.com/go-co-op/gocron"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/forbole/bdjuno/database"
+	profilesutils "github.com/forbole/bdjuno/modules/profiles/utils"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.FastSyncModule           = &Module{}
+	_ modules.GenesisModule            = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the Desmos x/profiles module
+type Module struct {
+	encodingConfig *params.EncodingConfig
+	authClient     authtypes.QueryClient
+	profilesClient profilestypes.QueryClient
+	db             *database.Db
+}
+
+// NewModule returns a new Module instance
+func NewModule(
+	authClient authtypes.QueryClient, profilesClient profilestypes.QueryClient,
+	encodingConfig *params.EncodingConfig, db *database.Db,
+) *Module {
+	return &Module{
+		encodingConfig: encodingConfig,
+		authClient:     authClient,
+		profilesClient: profilesClient,
+		db:             db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "profiles"
+}
+
+// DownloadState implements modules.FastSyncModule
+func (m *Module) DownloadState(height int64) error {
+	return profilesutils.UpdateProfiles(height, m.authClient, m.profilesClient, m.encodingConfig.Marshaler, m.db)
+}
+
+// HandleGenesis implements modules.GenesisModule
+func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+	return HandleGenesis(doc, appState, m.encodingConfig.Marshaler, m.db)
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+	return HandleMsg(tx, index, msg, m.profilesClient, m.encodingConfig.Marshaler, m.db)
+}
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	return RegisterPeriodicOps(scheduler, m.authClient, m.profilesClient, m.encodingConfig.Marshaler, m.db)
+}
@@ -0,0 +1,378 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/desmos-labs/juno/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// ConvertProfile unpacks the given account and converts it into a Profile.
+// It returns nil if the account is not a Desmos profile
+func ConvertProfile(cdc codec.Marshaler, accountAny *codectypes.Any, height int64) (*types.Profile, error) {
+	var account authtypes.AccountI
+	err := cdc.UnpackAny(accountAny, &account)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := account.(*profilestypes.Profile)
+	if !ok {
+		return nil, nil
+	}
+
+	converted := types.NewProfile(profile, height)
+	return &converted, nil
+}
+
+// GetGenesisProfiles returns the profiles that are present among the genesis accounts
+func GetGenesisProfiles(
+	appState map[string]json.RawMessage, cdc codec.Marshaler, height int64,
+) ([]types.Profile, error) {
+	var authState authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authtypes.ModuleName], &authState); err != nil {
+		return nil, err
+	}
+
+	var profiles []types.Profile
+	for _, account := range authState.Accounts {
+		profile, err := ConvertProfile(cdc, account, height)
+		if err != nil {
+			return nil, err
+		}
+
+		if profile != nil {
+			profiles = append(profiles, *profile)
+		}
+	}
+
+	return profiles, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// RefreshProfile queries the profile of the given address at the provided height and stores it,
+// deleting the stored one if the profile does not exist anymore
+func RefreshProfile(
+	height int64, address string, profilesClient profilestypes.QueryClient, cdc codec.Marshaler, db *database.Db,
+) error {
+	res, err := profilesClient.Profile(
+		context.Background(),
+		&profilestypes.QueryProfileRequest{User: address},
+		client.GetHeightRequestHeader(height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while getting profile: %s", err)
+	}
+
+	if res.Profile == nil {
+		return db.DeleteProfile(address, height)
+	}
+
+	profile, err := ConvertProfile(cdc, res.Profile, height)
+	if err != nil {
+		return fmt.Errorf("error while converting profile: %s", err)
+	}
+
+	if profile == nil {
+		return nil
+	}
+
+	err = db.SaveAccounts([]types.Account{types.NewAccount(profile.Address)})
+	if err != nil {
+		return err
+	}
+
+	return db.SaveProfiles([]types.Profile{*profile})
+}
+
+// GetProfiles returns all the profiles that are present on chain at the given height
+func GetProfiles(
+	height int64, authClient authtypes.QueryClient, cdc codec.Marshaler,
+) ([]types.Profile, error) {
+	header := client.GetHeightRequestHeader(height)
+
+	var profiles []types.Profile
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := authClient.Accounts(
+			context.Background(),
+			&authtypes.QueryAccountsRequest{
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 accounts at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting accounts: %s", err)
+		}
+
+		for _, account := range res.Accounts {
+			profile, err := ConvertProfile(cdc, account, height)
+			if err != nil {
+				return nil, fmt.Errorf("error while converting profile: %s", err)
+			}
+
+			if profile != nil {
+				profiles = append(profiles, *profile)
+			}
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+	}
+
+	return profiles, nil
+}
+
+// UpdateProfiles replaces the stored profiles with all the ones present on chain at the given height,
+// along with the incoming DTag transfer requests, the relationships and the blocks of their owners.
+// The stored profiles that do not exist anymore are deleted
+func UpdateProfiles(
+	height int64, authClient authtypes.QueryClient, profilesClient profilestypes.QueryClient,
+	cdc codec.Marshaler, db *database.Db,
+) error {
+	log.Debug().Str("module", "profiles").Int64("height", height).Msg("updating profiles")
+
+	profiles, err := GetProfiles(height, authClient, cdc)
+	if err != nil {
+		return err
+	}
+
+	accounts := make([]types.Account, len(profiles))
+	onChain := map[string]bool{}
+	for index, profile := range profiles {
+		accounts[index] = types.NewAccount(profile.Address)
+		onChain[profile.Address] = true
+	}
+
+	err = db.SaveAccounts(accounts)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveProfiles(profiles)
+	if err != nil {
+		return err
+	}
+
+	stored, err := db.GetProfilesAddresses()
+	if err != nil {
+		return err
+	}
+
+	for _, address := range stored {
+		if !onChain[address] {
+			err = db.DeleteProfile(address, height)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = refreshUserData(height, address, profilesClient, db)
+		if err != nil {
+			log.Error().Str("module", "profiles").Str("address", address).Err(err).
+				Msg("error while refreshing profile data")
+		}
+	}
+
+	return nil
+}
+
+// refreshUserData replaces the incoming DTag transfer requests, the relationships and the blocks
+// of the given user with the ones present on chain at the given height
+func refreshUserData(height int64, address string, profilesClient profilestypes.QueryClient, db *database.Db) error {
+	header := client.GetHeightRequestHeader(height)
+
+	var requests []profilestypes.DTagTransferRequest
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := profilesClient.IncomingDTagTransferRequests(
+			context.Background(),
+			&profilestypes.QueryIncomingDTagTransferRequestsRequest{
+				Receiver: address,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 requests at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return fmt.Errorf("error while getting incoming DTag transfer requests: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		requests = append(requests, res.Requests...)
+	}
+
+	var relationships []profilestypes.Relationship
+	nextKey, stop = nil, false
+	for !stop {
+		res, err := profilesClient.UserRelationships(
+			context.Background(),
+			&profilestypes.QueryUserRelationshipsRequest{
+				User: address,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 relationships at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return fmt.Errorf("error while getting user relationships: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		relationships = append(relationships, res.Relationships...)
+	}
+
+	var blocks []profilestypes.UserBlock
+	nextKey, stop = nil, false
+	for !stop {
+		res, err := profilesClient.UserBlocks(
+			context.Background(),
+			&profilestypes.QueryUserBlocksRequest{
+				User: address,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 blocks at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return fmt.Errorf("error while getting user blocks: %s", err)
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		blocks = append(blocks, res.Blocks...)
+	}
+
+	err := db.DeleteIncomingDTagTransferRequests(address, height)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteUserRelationships(address, height)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteUserBlocks(address, height)
+	if err != nil {
+		return err
+	}
+
+	return SaveProfilesData(ConvertProfilesData(height, requests, relationships, blocks), db)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProfilesData contains the DTag transfer requests, relationships and blocks that should be stored together
+type ProfilesData struct {
+	Requests      []types.DTagTransferRequest
+	Relationships []types.Relationship
+	Blocks        []types.UserBlock
+}
+
+// ConvertProfilesData converts the given DTag transfer requests, relationships and blocks into a ProfilesData
+func ConvertProfilesData(
+	height int64,
+	requests []profilestypes.DTagTransferRequest,
+	relationships []profilestypes.Relationship,
+	blocks []profilestypes.UserBlock,
+) ProfilesData {
+	data := ProfilesData{
+		Requests:      make([]types.DTagTransferRequest, len(requests)),
+		Relationships: make([]types.Relationship, len(relationships)),
+		Blocks:        make([]types.UserBlock, len(blocks)),
+	}
+
+	for index, request := range requests {
+		data.Requests[index] = types.NewDTagTransferRequest(request, height)
+	}
+
+	for index, relationship := range relationships {
+		data.Relationships[index] = types.NewRelationship(relationship, height)
+	}
+
+	for index, block := range blocks {
+		data.Blocks[index] = types.NewUserBlock(block, height)
+	}
+
+	return data
+}
+
+// GetAddresses returns the addresses of all the accounts involved inside the data, without duplicates
+func (data ProfilesData) GetAddresses() []string {
+	var addresses []string
+	seen := map[string]bool{}
+	add := func(values ...string) {
+		for _, value := range values {
+			if !seen[value] {
+				seen[value] = true
+				addresses = append(addresses, value)
+			}
+		}
+	}
+
+	for _, request := range data.Requests {
+		add(request.Sender, request.Receiver)
+	}
+
+	for _, relationship := range data.Relationships {
+		add(relationship.Creator, relationship.Recipient)
+	}
+
+	for _, block := range data.Blocks {
+		add(block.Blocker, block.Blocked)
+	}
+
+	return addresses
+}
+
+// SaveProfilesData stores the given data, making sure all the involved accounts exist first
+func SaveProfilesData(data ProfilesData, db *database.Db) error {
+	addresses := data.GetAddresses()
+	accounts := make([]types.Account, len(addresses))
+	for index, address := range addresses {
+		accounts[index] = types.NewAccount(address)
+	}
+
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveDTagTransferRequests(data.Requests)
+	if err != nil {
+		return err
+	}
+
+	err = db.SaveRelationships(data.Relationships)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveUserBlocks(data.Blocks)
+}
@@ -0,0 +1,34 @@
+package utils_test
+
+import (
+	"testing"
+
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/stretchr/testify/require"
+
+	profilesutils "github.com/forbole/bdjuno/modules/profiles/utils"
+)
+
+func TestProfilesData_GetAddresses(t *testing.T) {
+	data := profilesutils.ConvertProfilesData(
+		10,
+		[]profilestypes.DTagTransferRequest{
+			profilestypes.NewDTagTransferRequest("dtag", "desmos1sender", "desmos1receiver"),
+		},
+		[]profilestypes.Relationship{
+			profilestypes.NewRelationship("desmos1sender", "desmos1recipient", "subspace"),
+		},
+		[]profilestypes.UserBlock{
+			profilestypes.NewUserBlock("desmos1receiver", "desmos1blocked", "reason", "subspace"),
+		},
+	)
+
+	require.Len(t, data.Requests, 1)
+	require.Equal(t, int64(10), data.Requests[0].Height)
+	require.Len(t, data.Relationships, 1)
+	require.Len(t, data.Blocks, 1)
+
+	require.Equal(t, []string{
+		"desmos1sender", "desmos1receiver", "desmos1recipient", "desmos1blocked",
+	}, data.GetAddresses())
+}
@@ -7,15 +7,22 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
 	"github.com/desmos-labs/juno/client"
 	"github.com/desmos-labs/juno/db"
 	jmodules "github.com/desmos-labs/juno/modules"
 	"github.com/desmos-labs/juno/modules/messages"
 	"github.com/desmos-labs/juno/modules/registrar"
 	juno "github.com/desmos-labs/juno/types"
+	"google.golang.org/grpc"
 
 	"github.com/forbole/bdjuno/database"
 	"github.com/forbole/bdjuno/modules/auth"
@@ -23,34 +30,61 @@ import (
 	"github.com/forbole/bdjuno/modules/consensus"
 	"github.com/forbole/bdjuno/modules/distribution"
 	"github.com/forbole/bdjuno/modules/gov"
+	"github.com/forbole/bdjuno/modules/ibc"
 	"github.com/forbole/bdjuno/modules/mint"
 	"github.com/forbole/bdjuno/modules/modules"
 	"github.com/forbole/bdjuno/modules/pricefeed"
+	"github.com/forbole/bdjuno/modules/profiles"
 	"github.com/forbole/bdjuno/modules/slashing"
 	"github.com/forbole/bdjuno/modules/staking"
+	"github.com/forbole/bdjuno/modules/upgrade"
 	"github.com/forbole/bdjuno/modules/utils"
+	"github.com/forbole/bdjuno/types/chains"
+	"github.com/forbole/bdjuno/types/config"
 )
 
 var (
 	_ registrar.Registrar = &Registrar{}
 )
 
-// Registrar represents the modules.Registrar that allows to register all modules that are supported by BigDipper
+const (
+	// blockResultsCacheSize is the number of heights whose block results are kept in memory,
+	// so that the modules that are handling the same block can share them
+	blockResultsCacheSize = 100
+)
+
+// Registrar represents the modules.Registrar that allows to register all modules that are supported by BigDipper.
+// The gRPC connection and the block results cache are created once and shared by all the modules it builds
 type Registrar struct {
+	chainProfiles  *chains.Registry
+	grpcConnection *grpc.ClientConn
+	blockResults   *utils.BlockResultsCache
 }
 
-// NewRegistrar allows to build a new Registrar instance
-func NewRegistrar() *Registrar {
-	return &Registrar{}
+// NewRegistrar allows to build a new Registrar instance that uses the
+// addresses parser of the configured profile among the given ones
+func NewRegistrar(chainProfiles *chains.Registry) *Registrar {
+	return &Registrar{
+		chainProfiles: chainProfiles,
+	}
 }
 
 // BuildModules implements modules.Registrar
 func (r *Registrar) BuildModules(
 	cfg juno.Config, encodingConfig *params.EncodingConfig, _ *sdk.Config, db db.Database, cp *client.Proxy,
 ) jmodules.Modules {
-	parser := utils.AddressesParser
+	profile := r.chainProfiles.MustGetConfiguredProfile(cfg)
+	parser := profile.AddressesParser
+	bdjunoCfg := config.Cast(cfg)
 	bigDipperBd := database.Cast(db)
-	grpcConnection := client.MustCreateGrpcConnection(cfg)
+	if r.grpcConnection == nil {
+		r.grpcConnection = client.MustCreateGrpcConnection(cfg)
+	}
+	if r.blockResults == nil {
+		r.blockResults = utils.NewBlockResultsCache(utils.MustCreateRPCClient(cfg), blockResultsCacheSize)
+	}
+
+	grpcConnection, blockResults := r.grpcConnection, r.blockResults
 
 	authClient := authttypes.NewQueryClient(grpcConnection)
 	bankClient := banktypes.NewQueryClient(grpcConnection)
@@ -59,18 +93,36 @@ func (r *Registrar) BuildModules(
 	mintClient := minttypes.NewQueryClient(grpcConnection)
 	slashingClient := slashingtypes.NewQueryClient(grpcConnection)
 	stakingClient := stakingtypes.NewQueryClient(grpcConnection)
+	upgradeClient := upgradetypes.NewQueryClient(grpcConnection)
+	transferClient := transfertypes.NewQueryClient(grpcConnection)
+	clientClient := clienttypes.NewQueryClient(grpcConnection)
+	connectionClient := connectiontypes.NewQueryClient(grpcConnection)
+	channelClient := channeltypes.NewQueryClient(grpcConnection)
+	profilesClient := profilestypes.NewQueryClient(grpcConnection)
 
-	return []jmodules.Module{
+	mods := []jmodules.Module{
 		messages.NewModule(parser, encodingConfig.Marshaler, db),
 		auth.NewModule(parser, authClient, encodingConfig, bigDipperBd),
-		bank.NewModule(parser, authClient, bankClient, encodingConfig, bigDipperBd),
+		bank.NewModule(
+			parser, blockResults, authClient, bankClient, distrClient, stakingClient,
+			bdjunoCfg.GetSupplyConfig(), encodingConfig, bigDipperBd,
+		),
 		consensus.NewModule(cp, bigDipperBd),
-		distribution.NewModule(distrClient, bigDipperBd),
-		gov.NewModule(bankClient, govClient, stakingClient, encodingConfig, bigDipperBd),
+		distribution.NewModule(bdjunoCfg.GetDistributionConfig(), distrClient, bigDipperBd),
+		gov.NewModule(blockResults, bankClient, govClient, stakingClient, encodingConfig, bigDipperBd),
+		ibc.NewModule(transferClient, clientClient, connectionClient, channelClient, encodingConfig, bigDipperBd),
 		mint.NewModule(mintClient, bigDipperBd),
 		modules.NewModule(cfg, bigDipperBd),
 		pricefeed.NewModule(encodingConfig, bigDipperBd),
 		slashing.NewModule(slashingClient, bigDipperBd),
-		staking.NewModule(bankClient, stakingClient, encodingConfig, bigDipperBd),
+		staking.NewModule(stakingClient, encodingConfig, bigDipperBd),
+		upgrade.NewModule(upgradeClient, bigDipperBd),
+	}
+
+	// The x/profiles module only exists on Desmos
+	if profile.Name == chains.DesmosProfileName {
+		mods = append(mods, profiles.NewModule(authClient, profilesClient, encodingConfig, bigDipperBd))
 	}
+
+	return mods
 }
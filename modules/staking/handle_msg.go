@@ -4,12 +4,9 @@ import (
 	"time"
 
 	"github.com/forbole/bdjuno/database"
-	bankutils "github.com/forbole/bdjuno/modules/bank/utils"
 	stakingutils "github.com/forbole/bdjuno/modules/staking/utils"
 	"github.com/forbole/bdjuno/types"
 
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -19,8 +16,7 @@ import (
 
 // HandleMsg allows to handle the different utils related to the staking module
 func HandleMsg(
-	tx *juno.Tx, index int, msg sdk.Msg, stakingClient stakingtypes.QueryClient, bankClient banktypes.QueryClient,
-	cdc codec.Marshaler, db *database.Db,
+	tx *juno.Tx, index int, msg sdk.Msg, stakingClient stakingtypes.QueryClient, cdc codec.Marshaler, db *database.Db,
 ) error {
 	if len(tx.Logs) == 0 {
 		return nil
@@ -40,7 +36,7 @@ func HandleMsg(
 		return handleMsgBeginRedelegate(tx, index, cosmosMsg, stakingClient, db)
 
 	case *stakingtypes.MsgUndelegate:
-		return handleMsgUndelegate(tx, index, cosmosMsg, stakingClient, bankClient, db)
+		return handleMsgUndelegate(tx, index, cosmosMsg, stakingClient, db)
 	}
 
 	return nil
@@ -135,18 +131,17 @@ func handleMsgBeginRedelegate(
 // handleMsgUndelegate handles a MsgUndelegate storing the data inside the database
 func handleMsgUndelegate(
 	tx *juno.Tx, index int, msg *stakingtypes.MsgUndelegate,
-	stakingClient stakingtypes.QueryClient, bankClient banktypes.QueryClient, db *database.Db,
+	stakingClient stakingtypes.QueryClient, db *database.Db,
 ) error {
 	delegation, err := stakingutils.StoreUnbondingDelegationFromMessage(tx, index, msg, db)
 	if err != nil {
 		return err
 	}
 
-	// When timer expires update the delegations, update the user balance and remove the unbonding delegation
+	// When timer expires update the delegations and remove the unbonding delegation.
+	// The user balance is updated by the bank module when handling the complete_unbonding event
 	time.AfterFunc(time.Until(delegation.CompletionTimestamp),
 		stakingutils.RefreshDelegations(tx.Height, msg.DelegatorAddress, stakingClient, db))
-	time.AfterFunc(time.Until(delegation.CompletionTimestamp),
-		bankutils.RefreshBalance(msg.DelegatorAddress, bankClient, db))
 	time.AfterFunc(time.Until(delegation.CompletionTimestamp),
 		stakingutils.DeleteUnbondingDelegation(*delegation, db))
 
@@ -5,8 +5,6 @@ import (
 
 	"github.com/forbole/bdjuno/database"
 
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-
 	"github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -27,19 +25,16 @@ var (
 type Module struct {
 	encodingConfig *params.EncodingConfig
 	stakingClient  stakingtypes.QueryClient
-	bankClient     banktypes.QueryClient
 	db             *database.Db
 }
 
 // NewModule returns a new Module instance
 func NewModule(
-	bankClient banktypes.QueryClient, stakingClient stakingtypes.QueryClient,
-	encodingConfig *params.EncodingConfig, db *database.Db,
+	stakingClient stakingtypes.QueryClient, encodingConfig *params.EncodingConfig, db *database.Db,
 ) *Module {
 	return &Module{
 		encodingConfig: encodingConfig,
 		stakingClient:  stakingClient,
-		bankClient:     bankClient,
 		db:             db,
 	}
 }
@@ -61,5 +56,5 @@ func (m *Module) HandleBlock(block *tmctypes.ResultBlock, _ []*types.Tx, vals *t
 
 // HandleMsg implements MessageModule
 func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *types.Tx) error {
-	return HandleMsg(tx, index, msg, m.stakingClient, m.bankClient, m.encodingConfig.Marshaler, m.db)
+	return HandleMsg(tx, index, msg, m.stakingClient, m.encodingConfig.Marshaler, m.db)
 }
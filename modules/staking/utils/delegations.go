@@ -90,6 +90,43 @@ func getDelegationsFromGrpc(
 	}
 }
 
+// GetValidatorDelegations returns all the delegations that the validator having the given operator address
+// has at the given height
+func GetValidatorDelegations(
+	height int64, validatorAddress string, stakingClient stakingtypes.QueryClient,
+) ([]types.Delegation, error) {
+	header := client.GetHeightRequestHeader(height)
+
+	var delegations []types.Delegation
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := stakingClient.ValidatorDelegations(
+			context.Background(),
+			&stakingtypes.QueryValidatorDelegationsRequest{
+				ValidatorAddr: validatorAddress,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 delegations at time
+				},
+			},
+			header,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, delegation := range res.DelegationResponses {
+			delegations = append(delegations, ConvertDelegationResponse(height, delegation))
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+	}
+
+	return delegations, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // UpdateDelegations updates the current delegations for the given delegator by removing all the existing ones and
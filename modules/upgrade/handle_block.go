@@ -0,0 +1,71 @@
+package upgrade
+
+import (
+	"context"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/desmos-labs/juno/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/database"
+	"github.com/forbole/bdjuno/types"
+)
+
+// HandleBlock represents a method that is called each time a new block is created
+func HandleBlock(height int64, upgradeClient upgradetypes.QueryClient, db *database.Db) error {
+	err := updateUpgradePlan(height, upgradeClient, db)
+	if err != nil {
+		log.Error().Str("module", "upgrade").Int64("height", height).
+			Err(err).Msg("error while updating upgrade plan")
+	}
+
+	return nil
+}
+
+// updateUpgradePlan gets the upgrade plan present at the given height and stores it inside the database.
+// When there is no plan anymore, the stored one is marked as applied if its height has been reached,
+// or removed otherwise as it has been cancelled
+func updateUpgradePlan(height int64, upgradeClient upgradetypes.QueryClient, db *database.Db) error {
+	log.Debug().Str("module", "upgrade").Int64("height", height).
+		Msg("updating upgrade plan")
+
+	header := client.GetHeightRequestHeader(height)
+	res, err := upgradeClient.CurrentPlan(context.Background(), &upgradetypes.QueryCurrentPlanRequest{}, header)
+	if err != nil {
+		return err
+	}
+
+	if res.Plan != nil {
+		return db.SaveUpgradePlan(types.NewUpgradePlan(*res.Plan, 0, height))
+	}
+
+	stored, err := db.GetUpgradePlan()
+	if err != nil {
+		return err
+	}
+
+	if stored == nil || stored.Height > height {
+		return nil
+	}
+
+	if stored.UpgradeHeight > height {
+		return db.DeleteUpgradePlan(height)
+	}
+
+	appliedRes, err := upgradeClient.AppliedPlan(
+		context.Background(),
+		&upgradetypes.QueryAppliedPlanRequest{Name: stored.Name},
+		header,
+	)
+	if err != nil {
+		return err
+	}
+
+	if appliedRes.Height == 0 {
+		return db.DeleteUpgradePlan(height)
+	}
+
+	return db.SaveAppliedUpgrade(
+		types.NewAppliedUpgrade(stored.Name, stored.Info, stored.ProposalID, appliedRes.Height),
+	)
+}
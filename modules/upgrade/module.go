@@ -0,0 +1,39 @@
+package upgrade
+
+import (
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/desmos-labs/juno/modules"
+	juno "github.com/desmos-labs/juno/types"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/forbole/bdjuno/database"
+)
+
+var (
+	_ modules.Module      = &Module{}
+	_ modules.BlockModule = &Module{}
+)
+
+// Module represents the x/upgrade module
+type Module struct {
+	upgradeClient upgradetypes.QueryClient
+	db            *database.Db
+}
+
+// NewModule returns a new Module instance
+func NewModule(upgradeClient upgradetypes.QueryClient, db *database.Db) *Module {
+	return &Module{
+		upgradeClient: upgradeClient,
+		db:            db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "upgrade"
+}
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(block *tmctypes.ResultBlock, _ []*juno.Tx, _ *tmctypes.ResultValidators) error {
+	return HandleBlock(block.Block.Height, m.upgradeClient, m.db)
+}
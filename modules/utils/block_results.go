@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// BlockResultsCache allows different modules to get the results of the same block while querying them only once.
+// Only the results of the latest requested heights are kept, and failed queries are not cached
+type BlockResultsCache struct {
+	rpcClient rpcclient.Client
+	size      int
+
+	mu      sync.Mutex
+	entries map[int64]*blockResultsEntry
+	heights []int64
+}
+
+// blockResultsEntry contains the results of a single block, which are queried only once
+type blockResultsEntry struct {
+	once    sync.Once
+	results *tmctypes.ResultBlockResults
+	err     error
+}
+
+// NewBlockResultsCache returns a new BlockResultsCache that uses the given client and keeps
+// the results of at most size heights
+func NewBlockResultsCache(rpcClient rpcclient.Client, size int) *BlockResultsCache {
+	return &BlockResultsCache{
+		rpcClient: rpcClient,
+		size:      size,
+		entries:   map[int64]*blockResultsEntry{},
+	}
+}
+
+// GetBlockResults returns the results of the block at the given height
+func (c *BlockResultsCache) GetBlockResults(height int64) (*tmctypes.ResultBlockResults, error) {
+	entry := c.getEntry(height)
+	entry.once.Do(func() {
+		entry.results, entry.err = c.rpcClient.BlockResults(context.Background(), &height)
+	})
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[height] == entry {
+			delete(c.entries, height)
+		}
+		c.mu.Unlock()
+	}
+
+	return entry.results, entry.err
+}
+
+// getEntry returns the entry of the given height, creating it and evicting the oldest one if needed
+func (c *BlockResultsCache) getEntry(height int64) *blockResultsEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.entries[height]
+	if found {
+		return entry
+	}
+
+	entry = &blockResultsEntry{}
+	c.entries[height] = entry
+	c.heights = append(c.heights, height)
+
+	if len(c.heights) > c.size {
+		delete(c.entries, c.heights[0])
+		c.heights = c.heights[1:]
+	}
+
+	return entry
+}
@@ -0,0 +1,59 @@
+package utils_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/forbole/bdjuno/modules/utils"
+)
+
+// mockBlockResultsClient is a rpcclient.Client that counts the block results queries made for each height
+type mockBlockResultsClient struct {
+	rpcclient.Client
+	calls map[int64]int
+	fail  bool
+}
+
+func (c *mockBlockResultsClient) BlockResults(_ context.Context, height *int64) (*tmctypes.ResultBlockResults, error) {
+	c.calls[*height]++
+	if c.fail {
+		return nil, fmt.Errorf("error")
+	}
+	return &tmctypes.ResultBlockResults{Height: *height}, nil
+}
+
+func TestBlockResultsCache_GetBlockResults(t *testing.T) {
+	client := &mockBlockResultsClient{calls: map[int64]int{}}
+	cache := utils.NewBlockResultsCache(client, 2)
+
+	for i := 0; i < 3; i++ {
+		res, err := cache.GetBlockResults(10)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), res.Height)
+	}
+	require.Equal(t, 1, client.calls[10], "results should be queried only once")
+
+	// Evict the results of height 10
+	_, err := cache.GetBlockResults(11)
+	require.NoError(t, err)
+	_, err = cache.GetBlockResults(12)
+	require.NoError(t, err)
+
+	_, err = cache.GetBlockResults(10)
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls[10], "evicted results should be queried again")
+
+	// Make sure failed queries are not cached
+	client.fail = true
+	_, err = cache.GetBlockResults(20)
+	require.Error(t, err)
+	client.fail = false
+	_, err = cache.GetBlockResults(20)
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls[20])
+}
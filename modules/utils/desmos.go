@@ -7,9 +7,9 @@ import (
 	junomessages "github.com/desmos-labs/juno/modules/messages"
 )
 
-// desmosMessageAddressesParser represents a parser able to get the addresses of the involved
+// DesmosMessageAddressesParser represents a parser able to get the addresses of the involved
 // account from a Desmos message
-var desmosMessageAddressesParser = junomessages.JoinMessageParsers(
+var DesmosMessageAddressesParser = junomessages.JoinMessageParsers(
 	profilesMessageAddressesParser,
 )
 
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitEventRecords splits the attributes of the given event into different records.
+// This is needed as events having the same type are merged together inside the tx logs
+func SplitEventRecords(event sdk.StringEvent) []map[string]string {
+	var records []map[string]string
+	var current map[string]string
+	for _, attr := range event.Attributes {
+		if _, found := current[attr.Key]; found || current == nil {
+			current = map[string]string{}
+			records = append(records, current)
+		}
+		current[attr.Key] = attr.Value
+	}
+	return records
+}
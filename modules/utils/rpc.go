@@ -0,0 +1,18 @@
+package utils
+
+import (
+	juno "github.com/desmos-labs/juno/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	httpclient "github.com/tendermint/tendermint/rpc/client/http"
+)
+
+// MustCreateRPCClient creates a new Tendermint RPC client based on the given configuration.
+// It panics if the client cannot be created.
+func MustCreateRPCClient(cfg juno.Config) rpcclient.Client {
+	rpcClient, err := httpclient.New(cfg.GetRPCConfig().GetAddress(), "/websocket")
+	if err != nil {
+		panic(err)
+	}
+
+	return rpcClient
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
 // Account represents a chain account
 type Account struct {
 	Address string
@@ -11,3 +19,143 @@ func NewAccount(address string) Account {
 		Address: address,
 	}
 }
+
+// Types of accounts that can be stored
+const (
+	AccountTypeBase     = "base"
+	AccountTypeModule   = "module"
+	AccountTypeVesting  = "vesting"
+	AccountTypeMultisig = "multisig"
+)
+
+// AccountDetails contains all the details of a chain account
+type AccountDetails struct {
+	Address       string
+	Type          string
+	PubKey        cryptotypes.PubKey
+	AccountNumber uint64
+	Sequence      uint64
+	ModuleName    string
+	Height        int64
+}
+
+// NewAccountDetails builds a new AccountDetails instance from the given account
+func NewAccountDetails(account authttypes.AccountI, height int64) AccountDetails {
+	var moduleName string
+	if moduleAccount, ok := account.(authttypes.ModuleAccountI); ok {
+		moduleName = moduleAccount.GetName()
+	}
+
+	return AccountDetails{
+		Address:       account.GetAddress().String(),
+		Type:          GetAccountType(account),
+		PubKey:        account.GetPubKey(),
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+		ModuleName:    moduleName,
+		Height:        height,
+	}
+}
+
+// GetAccountType returns the type of the given account
+func GetAccountType(account authttypes.AccountI) string {
+	switch account.(type) {
+	case authttypes.ModuleAccountI:
+		return AccountTypeModule
+	case exported.VestingAccount:
+		return AccountTypeVesting
+	}
+
+	return GetPubKeyAccountType(account.GetPubKey())
+}
+
+// GetPubKeyAccountType returns the type of the account having the given public key
+func GetPubKeyAccountType(pubKey cryptotypes.PubKey) string {
+	if _, ok := pubKey.(*multisig.LegacyAminoPubKey); ok {
+		return AccountTypeMultisig
+	}
+	return AccountTypeBase
+}
+
+// AccountSignature contains the data of an account that has signed a transaction
+type AccountSignature struct {
+	Address  string
+	PubKey   cryptotypes.PubKey
+	Sequence uint64
+	Height   int64
+}
+
+// NewAccountSignature builds a new AccountSignature instance
+func NewAccountSignature(address string, pubKey cryptotypes.PubKey, sequence uint64, height int64) AccountSignature {
+	return AccountSignature{
+		Address:  address,
+		PubKey:   pubKey,
+		Sequence: sequence,
+		Height:   height,
+	}
+}
+
+// MultisigAccount contains the data of a multisig account
+type MultisigAccount struct {
+	Address   string
+	Threshold uint32
+	Members   []string
+	Height    int64
+}
+
+// NewMultisigAccount builds a new MultisigAccount instance starting from the given multisig public key
+func NewMultisigAccount(pubKey *multisig.LegacyAminoPubKey, height int64) MultisigAccount {
+	var members = make([]string, len(pubKey.GetPubKeys()))
+	for index, member := range pubKey.GetPubKeys() {
+		members[index] = sdk.AccAddress(member.Address()).String()
+	}
+
+	return MultisigAccount{
+		Address:   sdk.AccAddress(pubKey.Address()).String(),
+		Threshold: pubKey.Threshold,
+		Members:   members,
+		Height:    height,
+	}
+}
+
+// MultisigSignature contains the members of a multisig account that have signed a transaction
+type MultisigSignature struct {
+	TxHash          string
+	MultisigAddress string
+	Signers         []string
+	Height          int64
+}
+
+// NewMultisigSignature builds a new MultisigSignature instance
+func NewMultisigSignature(txHash, multisigAddress string, signers []string, height int64) MultisigSignature {
+	return MultisigSignature{
+		TxHash:          txHash,
+		MultisigAddress: multisigAddress,
+		Signers:         signers,
+		Height:          height,
+	}
+}
+
+// VestingBalance contains the vesting amounts of a vesting account at a given height
+type VestingBalance struct {
+	Address          string
+	Vested           sdk.Coins
+	Unvested         sdk.Coins
+	DelegatedVesting sdk.Coins
+	DelegatedFree    sdk.Coins
+	Height           int64
+}
+
+// NewVestingBalance builds a new VestingBalance instance
+func NewVestingBalance(
+	address string, vested, unvested, delegatedVesting, delegatedFree sdk.Coins, height int64,
+) VestingBalance {
+	return VestingBalance{
+		Address:          address,
+		Vested:           vested,
+		Unvested:         unvested,
+		DelegatedVesting: delegatedVesting,
+		DelegatedFree:    delegatedFree,
+		Height:           height,
+	}
+}
@@ -17,3 +17,40 @@ func NewAccountBalance(address string, balance sdk.Coins, height int64) AccountB
 		Height:  height,
 	}
 }
+
+const (
+	BalanceChangeCauseTransfer   = "transfer"
+	BalanceChangeCauseFee        = "fee"
+	BalanceChangeCauseReward     = "reward"
+	BalanceChangeCauseDelegation = "delegation"
+	BalanceChangeCauseUnbonding  = "unbonding"
+	BalanceChangeCauseSlash      = "slash"
+
+	// BalanceChangeCauseInternal identifies the movements between module accounts
+	// (eg. from the fee collector to the distribution module)
+	BalanceChangeCauseInternal = "internal"
+)
+
+// BalanceChange represents a single change of the balance of an account.
+// The amount is negative when the coins have been removed from the account
+type BalanceChange struct {
+	Address string
+	Denom   string
+	Amount  sdk.Int
+	Cause   string
+	Height  int64
+	TxHash  string
+}
+
+// NewBalanceChange allows to build a new BalanceChange instance.
+// The txHash should be empty if the change has happened during the BeginBlock or EndBlock
+func NewBalanceChange(address, denom string, amount sdk.Int, cause string, height int64, txHash string) BalanceChange {
+	return BalanceChange{
+		Address: address,
+		Denom:   denom,
+		Amount:  amount,
+		Cause:   cause,
+		Height:  height,
+		TxHash:  txHash,
+	}
+}
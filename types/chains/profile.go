@@ -0,0 +1,106 @@
+package chains
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	junomessages "github.com/desmos-labs/juno/modules/messages"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/types/config"
+	"github.com/forbole/bdjuno/types/encoding"
+)
+
+const (
+	// DesmosProfileName is the name of the profile that should be used to parse the Desmos chain
+	DesmosProfileName = "desmos"
+)
+
+// Profile contains all the chain-specific data that is needed to parse a chain
+type Profile struct {
+	// Name is the value of the chain_profile config key that selects this profile
+	Name string
+
+	// Encoding contains the encoding configs to be used to decode the chain data at each height
+	Encoding *encoding.Registry
+
+	// AddressesParser allows to get the addresses involved in both the Cosmos and the chain-specific messages
+	AddressesParser junomessages.MessageAddressesParser
+}
+
+// NewProfile returns a new Profile having the given name and encoding configs.
+// The given parsers are used along with the Cosmos one to get the addresses involved in the chain-specific messages
+func NewProfile(
+	name string, encodingRegistry *encoding.Registry, parsers ...junomessages.MessageAddressesParser,
+) *Profile {
+	return &Profile{
+		Name:     name,
+		Encoding: encodingRegistry,
+		AddressesParser: junomessages.JoinMessageParsers(
+			append([]junomessages.MessageAddressesParser{junomessages.CosmosMessageAddressesParser}, parsers...)...,
+		),
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// Registry contains all the chain profiles that can be selected using the chain_profile config key
+type Registry struct {
+	defaultProfile *Profile
+	profiles       map[string]*Profile
+}
+
+// NewRegistry returns a new Registry containing the given profiles.
+// The default profile is used when no chain profile is configured
+func NewRegistry(defaultProfile *Profile, profiles ...*Profile) *Registry {
+	registry := &Registry{
+		defaultProfile: defaultProfile,
+		profiles:       map[string]*Profile{defaultProfile.Name: defaultProfile},
+	}
+
+	for _, profile := range profiles {
+		registry.profiles[profile.Name] = profile
+	}
+
+	return registry
+}
+
+// GetProfile returns the profile having the given name, or the default one if the name is empty
+func (r *Registry) GetProfile(name string) (*Profile, error) {
+	if name == "" {
+		return r.defaultProfile, nil
+	}
+
+	profile, ok := r.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported chain profile: %s", name)
+	}
+
+	return profile, nil
+}
+
+// GetConfiguredProfile returns the profile selected by the chain_profile key of the given config
+func (r *Registry) GetConfiguredProfile(cfg juno.Config) (*Profile, error) {
+	return r.GetProfile(config.Cast(cfg).GetChainProfile())
+}
+
+// MustGetConfiguredProfile returns the profile selected by the chain_profile key of the given config,
+// panicking if such profile does not exist
+func (r *Registry) MustGetConfiguredProfile(cfg juno.Config) *Profile {
+	profile, err := r.GetConfiguredProfile(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return profile
+}
+
+// BuildLatestEncodingConfig builds the latest encoding config of the profile selected by the given config,
+// based on the upgrades configured inside it. It should be used by all the commands that do not parse the chain
+func (r *Registry) BuildLatestEncodingConfig(cfg juno.Config) (params.EncodingConfig, error) {
+	profile, err := r.GetConfiguredProfile(cfg)
+	if err != nil {
+		return params.EncodingConfig{}, err
+	}
+
+	return profile.Encoding.BuildLatest(config.Cast(cfg).GetEncodingConfig().GetUpgrades())
+}
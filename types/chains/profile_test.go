@@ -0,0 +1,60 @@
+package chains_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	junomessages "github.com/desmos-labs/juno/modules/messages"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/types/chains"
+	"github.com/forbole/bdjuno/types/encoding"
+)
+
+func TestRegistry_GetProfile(t *testing.T) {
+	desmos := chains.NewProfile("desmos", encoding.NewRegistry(simapp.MakeTestEncodingConfig))
+	cosmos := chains.NewProfile("cosmos", encoding.NewRegistry(simapp.MakeTestEncodingConfig))
+	registry := chains.NewRegistry(desmos, cosmos)
+
+	profile, err := registry.GetProfile("")
+	require.NoError(t, err)
+	require.Equal(t, desmos, profile)
+
+	profile, err = registry.GetProfile("cosmos")
+	require.NoError(t, err)
+	require.Equal(t, cosmos, profile)
+
+	_, err = registry.GetProfile("unknown")
+	require.Error(t, err)
+}
+
+func TestProfile_AddressesParser(t *testing.T) {
+	profile := chains.NewProfile(
+		"custom",
+		encoding.NewRegistry(simapp.MakeTestEncodingConfig),
+		func(_ codec.Marshaler, cosmosMsg sdk.Msg) ([]string, error) {
+			if msg, ok := cosmosMsg.(*channeltypes.MsgChannelOpenInit); ok {
+				return []string{msg.Signer}, nil
+			}
+			return nil, junomessages.MessageNotSupported(cosmosMsg)
+		},
+	)
+
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	sender := sdk.AccAddress("sender").String()
+	recipient := sdk.AccAddress("recipient").String()
+
+	// Make sure the Cosmos messages are supported
+	addresses, err := profile.AddressesParser(cdc, &banktypes.MsgSend{FromAddress: sender, ToAddress: recipient})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{sender, recipient}, addresses)
+
+	// Make sure the custom messages are supported
+	addresses, err = profile.AddressesParser(cdc, &channeltypes.MsgChannelOpenInit{Signer: sender})
+	require.NoError(t, err)
+	require.Equal(t, []string{sender}, addresses)
+}
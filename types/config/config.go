@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+
 	juno "github.com/desmos-labs/juno/types"
 )
 
@@ -11,21 +13,61 @@ var (
 // Config contains the configuration data for the parser
 type Config struct {
 	juno.Config
+	chainProfile   string
 	databaseConfig *DatabaseConfig
+	supplyConfig   *SupplyConfig
+	distrConfig    *DistributionConfig
+	encodingConfig *EncodingConfig
 }
 
 // NewConfig allows to build a new Config instance
-func NewConfig(junoCfg juno.Config, databaseCfg *DatabaseConfig) juno.Config {
+func NewConfig(
+	junoCfg juno.Config, chainProfile string, databaseCfg *DatabaseConfig, supplyCfg *SupplyConfig,
+	distrCfg *DistributionConfig, encodingCfg *EncodingConfig,
+) juno.Config {
 	return &Config{
 		Config:         junoCfg,
+		chainProfile:   chainProfile,
 		databaseConfig: databaseCfg,
+		supplyConfig:   supplyCfg,
+		distrConfig:    distrCfg,
+		encodingConfig: encodingCfg,
 	}
 }
 
+// GetChainProfile returns the name of the profile of the chain to be parsed
+func (c *Config) GetChainProfile() string {
+	return c.chainProfile
+}
+
 func (c *Config) GetDatabaseConfig() juno.DatabaseConfig {
 	return c.databaseConfig
 }
 
+// GetSupplyConfig returns the configuration used when computing the circulating supply
+func (c *Config) GetSupplyConfig() *SupplyConfig {
+	return c.supplyConfig
+}
+
+// GetDistributionConfig returns the configuration used by the distribution module
+func (c *Config) GetDistributionConfig() *DistributionConfig {
+	return c.distrConfig
+}
+
+// GetEncodingConfig returns the configuration used to select the encoding config of each height
+func (c *Config) GetEncodingConfig() *EncodingConfig {
+	return c.encodingConfig
+}
+
+// Cast allows to cast the given config to a Config instance
+func Cast(cfg juno.Config) *Config {
+	bdjunoCfg, ok := cfg.(*Config)
+	if !ok {
+		panic(fmt.Errorf("given config instance is not a Config"))
+	}
+	return bdjunoCfg
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 var _ juno.DatabaseConfig = &DatabaseConfig{}
@@ -48,3 +90,76 @@ func NewDatabaseConfig(junoDbCfg juno.DatabaseConfig, storeHistoricalData bool)
 func (d *DatabaseConfig) ShouldStoreHistoricalData() bool {
 	return d.StoreHistoricalData
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SupplyConfig contains the configuration used to compute the circulating supply
+type SupplyConfig struct {
+	ExcludedAddresses []string `toml:"excluded_addresses"`
+}
+
+// NewSupplyConfig allows to build a new SupplyConfig instance
+func NewSupplyConfig(excludedAddresses []string) *SupplyConfig {
+	return &SupplyConfig{
+		ExcludedAddresses: excludedAddresses,
+	}
+}
+
+// GetExcludedAddresses returns the addresses whose balances should not be considered as circulating
+func (s *SupplyConfig) GetExcludedAddresses() []string {
+	return s.ExcludedAddresses
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// DistributionConfig contains the configuration used by the distribution module
+type DistributionConfig struct {
+	ComputeRealizedAPR bool `toml:"compute_realized_apr"`
+}
+
+// NewDistributionConfig allows to build a new DistributionConfig instance
+func NewDistributionConfig(computeRealizedAPR bool) *DistributionConfig {
+	return &DistributionConfig{
+		ComputeRealizedAPR: computeRealizedAPR,
+	}
+}
+
+// ShouldComputeRealizedAPR tells whether or not the realized APR should be computed
+// from the actual rewards accrual
+func (d *DistributionConfig) ShouldComputeRealizedAPR() bool {
+	return d.ComputeRealizedAPR
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// EncodingConfig contains the configuration used to select the encoding config that should be used
+// to decode the data of each height
+type EncodingConfig struct {
+	Upgrades []*UpgradeConfig `toml:"upgrades"`
+}
+
+// NewEncodingConfig allows to build a new EncodingConfig instance
+func NewEncodingConfig(upgrades []*UpgradeConfig) *EncodingConfig {
+	return &EncodingConfig{
+		Upgrades: upgrades,
+	}
+}
+
+// GetUpgrades returns the chain upgrades after which a different encoding config should be used
+func (e *EncodingConfig) GetUpgrades() []*UpgradeConfig {
+	return e.Upgrades
+}
+
+// UpgradeConfig contains the name of a chain upgrade along with the height at which it took effect
+type UpgradeConfig struct {
+	Name   string `toml:"name"`
+	Height int64  `toml:"height"`
+}
+
+// NewUpgradeConfig allows to build a new UpgradeConfig instance
+func NewUpgradeConfig(name string, height int64) *UpgradeConfig {
+	return &UpgradeConfig{
+		Name:   name,
+		Height: height,
+	}
+}
@@ -6,7 +6,11 @@ import (
 )
 
 type configToml struct {
-	DatabaseConfig *DatabaseConfig `toml:"database"`
+	ChainProfile   string              `toml:"chain_profile"`
+	DatabaseConfig *DatabaseConfig     `toml:"database"`
+	SupplyConfig   *SupplyConfig       `toml:"supply"`
+	DistrConfig    *DistributionConfig `toml:"distribution"`
+	EncodingConfig *EncodingConfig     `toml:"encoding"`
 }
 
 // ParseConfig allows to read the given file contents as a Config instance
@@ -24,11 +28,30 @@ func ParseConfig(fileContents []byte) (juno.Config, error) {
 		return nil, err
 	}
 
+	supplyCfg := cfg.SupplyConfig
+	if supplyCfg == nil {
+		supplyCfg = NewSupplyConfig(nil)
+	}
+
+	distrCfg := cfg.DistrConfig
+	if distrCfg == nil {
+		distrCfg = NewDistributionConfig(false)
+	}
+
+	encodingCfg := cfg.EncodingConfig
+	if encodingCfg == nil {
+		encodingCfg = NewEncodingConfig(nil)
+	}
+
 	return NewConfig(
 		junoCfg,
+		cfg.ChainProfile,
 		NewDatabaseConfig(
 			junoCfg.GetDatabaseConfig(),
 			cfg.DatabaseConfig.StoreHistoricalData,
 		),
+		supplyCfg,
+		distrCfg,
+		encodingCfg,
 	), err
 }
@@ -29,3 +29,100 @@ func TestParseConfig(t *testing.T) {
 
 	require.Equal(t, true, dbConfig.ShouldStoreHistoricalData())
 }
+
+func TestParseConfig_SupplyConfig(t *testing.T) {
+	data := `
+[database]
+  store_historical_data = false
+  host = "localhost"
+  name = "juno"
+  password = "password"
+  port = 5432
+  schema = "public"
+  ssl_mode = ""
+  user = "user"
+
+[supply]
+  excluded_addresses = ["cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"]
+`
+
+	cfg, err := config.ParseConfig([]byte(data))
+	require.NoError(t, err)
+
+	supplyCfg := config.Cast(cfg).GetSupplyConfig()
+	require.Equal(t, []string{"cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"}, supplyCfg.GetExcludedAddresses())
+}
+
+func TestParseConfig_DistributionConfig(t *testing.T) {
+	data := `
+[database]
+  store_historical_data = true
+  host = "localhost"
+  name = "juno"
+  password = "password"
+  port = 5432
+  schema = "public"
+  ssl_mode = ""
+  user = "user"
+
+[distribution]
+  compute_realized_apr = true
+`
+
+	cfg, err := config.ParseConfig([]byte(data))
+	require.NoError(t, err)
+
+	distrCfg := config.Cast(cfg).GetDistributionConfig()
+	require.True(t, distrCfg.ShouldComputeRealizedAPR())
+}
+
+func TestParseConfig_EncodingConfig(t *testing.T) {
+	data := `
+[database]
+  store_historical_data = true
+  host = "localhost"
+  name = "juno"
+  password = "password"
+  port = 5432
+  schema = "public"
+  ssl_mode = ""
+  user = "user"
+
+[[encoding.upgrades]]
+  name = "v1.0.0"
+  height = 100
+
+[[encoding.upgrades]]
+  name = "v2.0.0"
+  height = 200
+`
+
+	cfg, err := config.ParseConfig([]byte(data))
+	require.NoError(t, err)
+
+	encodingCfg := config.Cast(cfg).GetEncodingConfig()
+	require.Equal(t, []*config.UpgradeConfig{
+		config.NewUpgradeConfig("v1.0.0", 100),
+		config.NewUpgradeConfig("v2.0.0", 200),
+	}, encodingCfg.GetUpgrades())
+}
+
+func TestParseConfig_ChainProfile(t *testing.T) {
+	data := `
+chain_profile = "cosmos"
+
+[database]
+  store_historical_data = true
+  host = "localhost"
+  name = "juno"
+  password = "password"
+  port = 5432
+  schema = "public"
+  ssl_mode = ""
+  user = "user"
+`
+
+	cfg, err := config.ParseConfig([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, "cosmos", config.Cast(cfg).GetChainProfile())
+}
@@ -7,26 +7,42 @@ import (
 )
 
 const (
+	flagChainProfile              = "chain-profile"
 	flagDatabaseStoreHistoricData = "database-store-historic-data"
+	flagSupplyExcludedAddresses   = "supply-excluded-addresses"
+	flagDistrComputeRealizedAPR   = "distribution-compute-realized-apr"
 )
 
 // SetupConfigFlags implements initcmd.ConfigFlagSetup
 func SetupConfigFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagChainProfile, "",
+		"Profile of the chain to be parsed, which determines its supported messages")
 	cmd.Flags().Bool(flagDatabaseStoreHistoricData, false,
 		"Whether or not to persist historic data inside the data")
+	cmd.Flags().StringSlice(flagSupplyExcludedAddresses, nil,
+		"List of addresses whose balances should not be considered part of the circulating supply")
+	cmd.Flags().Bool(flagDistrComputeRealizedAPR, false,
+		"Whether or not to compute the realized staking APR from the delegation rewards history")
 }
 
 // CreateConfig implements initcmd.ConfigCreator
 func CreateConfig(cmd *cobra.Command) juno.Config {
 	junoCfg := initcmd.DefaultConfigCreator(cmd)
 
+	chainProfile, _ := cmd.Flags().GetString(flagChainProfile)
 	storeHistoricData, _ := cmd.Flags().GetBool(flagDatabaseStoreHistoricData)
+	excludedAddresses, _ := cmd.Flags().GetStringSlice(flagSupplyExcludedAddresses)
+	computeRealizedAPR, _ := cmd.Flags().GetBool(flagDistrComputeRealizedAPR)
 
 	return NewConfig(
 		junoCfg,
+		chainProfile,
 		NewDatabaseConfig(
 			junoCfg.GetDatabaseConfig(),
 			storeHistoricData,
 		),
+		NewSupplyConfig(excludedAddresses),
+		NewDistributionConfig(computeRealizedAPR),
+		NewEncodingConfig(nil),
 	)
 }
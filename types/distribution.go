@@ -64,3 +64,84 @@ func NewDelegatorRewardAmount(
 		Height:            height,
 	}
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// StakingAPR contains the staking yield of the whole network at a given height
+type StakingAPR struct {
+	APR         sdk.Dec
+	APY         sdk.Dec
+	RealizedAPR *sdk.Dec
+	Height      int64
+}
+
+// NewStakingAPR allows to build a new StakingAPR instance
+func NewStakingAPR(apr, apy sdk.Dec, realizedAPR *sdk.Dec, height int64) StakingAPR {
+	return StakingAPR{
+		APR:         apr,
+		APY:         apy,
+		RealizedAPR: realizedAPR,
+		Height:      height,
+	}
+}
+
+// ValidatorAPR contains the staking yield that delegators of a validator get, net of commission
+type ValidatorAPR struct {
+	ValidatorConsAddr string
+	APR               sdk.Dec
+	APY               sdk.Dec
+	RealizedAPR       *sdk.Dec
+	Height            int64
+}
+
+// NewValidatorAPR allows to build a new ValidatorAPR instance
+func NewValidatorAPR(valConsAddr string, apr, apy sdk.Dec, realizedAPR *sdk.Dec, height int64) ValidatorAPR {
+	return ValidatorAPR{
+		ValidatorConsAddr: valConsAddr,
+		APR:               apr,
+		APY:               apy,
+		RealizedAPR:       realizedAPR,
+		Height:            height,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// CommunityPoolFund represents the funds that have been sent to the community pool using a MsgFundCommunityPool
+type CommunityPoolFund struct {
+	Depositor string
+	Amount    sdk.Coins
+	TxHash    string
+	MsgIndex  int
+	Height    int64
+}
+
+// NewCommunityPoolFund allows to build a new CommunityPoolFund instance
+func NewCommunityPoolFund(depositor string, amount sdk.Coins, txHash string, msgIndex int, height int64) CommunityPoolFund {
+	return CommunityPoolFund{
+		Depositor: depositor,
+		Amount:    amount,
+		TxHash:    txHash,
+		MsgIndex:  msgIndex,
+		Height:    height,
+	}
+}
+
+// CommunityPoolSpend represents the funds that have been sent from the community pool to a recipient
+// after the execution of a passed CommunityPoolSpendProposal
+type CommunityPoolSpend struct {
+	ProposalID uint64
+	Recipient  string
+	Amount     sdk.Coins
+	Height     int64
+}
+
+// NewCommunityPoolSpend allows to build a new CommunityPoolSpend instance
+func NewCommunityPoolSpend(proposalID uint64, recipient string, amount sdk.Coins, height int64) CommunityPoolSpend {
+	return CommunityPoolSpend{
+		ProposalID: proposalID,
+		Recipient:  recipient,
+		Amount:     amount,
+		Height:     height,
+	}
+}
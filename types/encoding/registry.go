@@ -0,0 +1,106 @@
+package encoding
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	juno "github.com/desmos-labs/juno/types"
+
+	"github.com/forbole/bdjuno/types/config"
+)
+
+// Range represents a range of heights that should be decoded using the same encoding config
+type Range struct {
+	// UpgradeName is the name of the upgrade that has started the range, empty for the genesis one
+	UpgradeName string
+
+	// StartHeight is the first height of the range
+	StartHeight int64
+
+	// EndHeight is the first height that is not part of the range, or 0 if the range has no end
+	EndHeight int64
+
+	// Builder allows to build the encoding config that should be used inside the range
+	Builder juno.EncodingConfigBuilder
+}
+
+// Contains tells whether the given height is part of the range
+func (r Range) Contains(height int64) bool {
+	return height >= r.StartHeight && (r.EndHeight == 0 || height < r.EndHeight)
+}
+
+// GetRangeIndex returns the index of the range containing the given height.
+// The given ranges must be contiguous and sorted by start height, as the ones returned by Registry.GetRanges
+func GetRangeIndex(ranges []Range, height int64) int {
+	for index, r := range ranges {
+		if r.Contains(height) {
+			return index
+		}
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// Registry contains the encoding configs builders that should be used before and after each chain upgrade
+type Registry struct {
+	genesisBuilder   juno.EncodingConfigBuilder
+	upgradesBuilders map[string]juno.EncodingConfigBuilder
+}
+
+// NewRegistry returns a new Registry that uses the given builder from the genesis up to the first upgrade
+func NewRegistry(genesisBuilder juno.EncodingConfigBuilder) *Registry {
+	return &Registry{
+		genesisBuilder:   genesisBuilder,
+		upgradesBuilders: map[string]juno.EncodingConfigBuilder{},
+	}
+}
+
+// RegisterUpgrade registers the builder of the encoding config to be used after the upgrade having the given name.
+// The height at which each upgrade took effect is read from the encoding configuration
+func (r *Registry) RegisterUpgrade(name string, builder juno.EncodingConfigBuilder) *Registry {
+	r.upgradesBuilders[name] = builder
+	return r
+}
+
+// GetRanges returns the ranges of heights sorted by start height, each one associated with the builder
+// of the encoding config that should be used inside it.
+// An error is returned if any of the given upgrades has no registered builder
+func (r *Registry) GetRanges(upgrades []*config.UpgradeConfig) ([]Range, error) {
+	sorted := make([]*config.UpgradeConfig, len(upgrades))
+	copy(sorted, upgrades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Height < sorted[j].Height
+	})
+
+	ranges := []Range{{StartHeight: 0, Builder: r.genesisBuilder}}
+	for _, upgrade := range sorted {
+		builder, ok := r.upgradesBuilders[upgrade.Name]
+		if !ok {
+			return nil, fmt.Errorf("no encoding config registered for upgrade %s", upgrade.Name)
+		}
+
+		last := &ranges[len(ranges)-1]
+		if upgrade.Height <= last.StartHeight {
+			return nil, fmt.Errorf("invalid height for upgrade %s: %d", upgrade.Name, upgrade.Height)
+		}
+
+		last.EndHeight = upgrade.Height
+		ranges = append(ranges, Range{UpgradeName: upgrade.Name, StartHeight: upgrade.Height, Builder: builder})
+	}
+
+	return ranges, nil
+}
+
+// BuildLatest builds the encoding config that should be used after the latest of the given upgrades.
+// It should be used by all the commands that do not parse the chain.
+// An error is returned if any of the given upgrades has no registered builder
+func (r *Registry) BuildLatest(upgrades []*config.UpgradeConfig) (params.EncodingConfig, error) {
+	ranges, err := r.GetRanges(upgrades)
+	if err != nil {
+		return params.EncodingConfig{}, err
+	}
+
+	return ranges[len(ranges)-1].Builder(), nil
+}
@@ -0,0 +1,89 @@
+package encoding_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/types/config"
+	"github.com/forbole/bdjuno/types/encoding"
+)
+
+func TestRegistry_GetRanges(t *testing.T) {
+	var built []string
+	builder := func(name string) func() params.EncodingConfig {
+		return func() params.EncodingConfig {
+			built = append(built, name)
+			return simapp.MakeTestEncodingConfig()
+		}
+	}
+
+	registry := encoding.NewRegistry(builder("genesis")).
+		RegisterUpgrade("v1", builder("v1")).
+		RegisterUpgrade("v2", builder("v2"))
+
+	ranges, err := registry.GetRanges([]*config.UpgradeConfig{
+		config.NewUpgradeConfig("v2", 200),
+		config.NewUpgradeConfig("v1", 100),
+	})
+	require.NoError(t, err)
+	require.Len(t, ranges, 3)
+
+	require.Equal(t, "", ranges[0].UpgradeName)
+	require.Equal(t, int64(0), ranges[0].StartHeight)
+	require.Equal(t, int64(100), ranges[0].EndHeight)
+
+	require.Equal(t, "v1", ranges[1].UpgradeName)
+	require.Equal(t, int64(100), ranges[1].StartHeight)
+	require.Equal(t, int64(200), ranges[1].EndHeight)
+
+	require.Equal(t, "v2", ranges[2].UpgradeName)
+	require.Equal(t, int64(200), ranges[2].StartHeight)
+	require.Equal(t, int64(0), ranges[2].EndHeight)
+
+	require.Equal(t, 0, encoding.GetRangeIndex(ranges, 0))
+	require.Equal(t, 0, encoding.GetRangeIndex(ranges, 99))
+	require.Equal(t, 1, encoding.GetRangeIndex(ranges, 100))
+	require.Equal(t, 1, encoding.GetRangeIndex(ranges, 199))
+	require.Equal(t, 2, encoding.GetRangeIndex(ranges, 200))
+	require.Equal(t, 2, encoding.GetRangeIndex(ranges, 1_000_000))
+
+	ranges[2].Builder()
+	require.Equal(t, []string{"v2"}, built)
+}
+
+func TestRegistry_GetRanges_InvalidUpgrades(t *testing.T) {
+	registry := encoding.NewRegistry(simapp.MakeTestEncodingConfig).
+		RegisterUpgrade("v1", simapp.MakeTestEncodingConfig)
+
+	_, err := registry.GetRanges([]*config.UpgradeConfig{config.NewUpgradeConfig("v2", 100)})
+	require.Error(t, err)
+
+	_, err = registry.GetRanges([]*config.UpgradeConfig{config.NewUpgradeConfig("v1", 0)})
+	require.Error(t, err)
+}
+
+func TestRegistry_BuildLatest(t *testing.T) {
+	var built []string
+	builder := func(name string) func() params.EncodingConfig {
+		return func() params.EncodingConfig {
+			built = append(built, name)
+			return simapp.MakeTestEncodingConfig()
+		}
+	}
+
+	registry := encoding.NewRegistry(builder("genesis")).
+		RegisterUpgrade("v1", builder("v1"))
+
+	_, err := registry.BuildLatest(nil)
+	require.NoError(t, err)
+
+	_, err = registry.BuildLatest([]*config.UpgradeConfig{config.NewUpgradeConfig("v1", 100)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"genesis", "v1"}, built)
+
+	_, err = registry.BuildLatest([]*config.UpgradeConfig{config.NewUpgradeConfig("v2", 100)})
+	require.Error(t, err, "unknown upgrades should return an error")
+}
@@ -231,3 +231,152 @@ func NewProposalValidatorStatusSnapshot(
 		Height:               height,
 	}
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+const (
+	// DepositOutcomeRefunded tells that the deposits of a proposal have been returned to the depositors
+	DepositOutcomeRefunded = "refunded"
+
+	// DepositOutcomeBurned tells that the deposits of a proposal have been burned, either because the deposit
+	// period has expired, the quorum has not been reached or the proposal has been vetoed
+	DepositOutcomeBurned = "burned"
+)
+
+// DepositOutcome contains the data about what happened to the deposits of a proposal once it has ended
+type DepositOutcome struct {
+	ProposalID uint64
+	Outcome    string
+	Height     int64
+}
+
+// NewDepositOutcome returns a new DepositOutcome instance
+func NewDepositOutcome(proposalID uint64, outcome string, height int64) DepositOutcome {
+	return DepositOutcome{
+		ProposalID: proposalID,
+		Outcome:    outcome,
+		Height:     height,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ProposalStatusRemoved represents the status of a proposal that has been removed from the chain
+// because its deposit period has ended without reaching the minimum deposit
+const ProposalStatusRemoved = "PROPOSAL_STATUS_REMOVED"
+
+// ProposalStatusChange contains the data about a proposal reaching a new status
+type ProposalStatusChange struct {
+	ProposalID uint64
+	Status     string
+	Height     int64
+	Timestamp  time.Time
+}
+
+// NewProposalStatusChange returns a new ProposalStatusChange instance
+func NewProposalStatusChange(proposalID uint64, status string, height int64, timestamp time.Time) ProposalStatusChange {
+	return ProposalStatusChange{
+		ProposalID: proposalID,
+		Status:     status,
+		Height:     height,
+		Timestamp:  timestamp,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ProposalValidatorTally contains the voting power that a validator has expressed towards a proposal, once removed
+// the stake of its delegators that have voted themselves. Option is empty if the validator has not voted
+type ProposalValidatorTally struct {
+	ProposalID       uint64
+	ValidatorAddress string
+	Option           string
+	TotalPower       sdk.Int
+	OverriddenPower  sdk.Int
+	EffectivePower   sdk.Int
+	Height           int64
+}
+
+// NewProposalValidatorTally allows to build a new ProposalValidatorTally instance
+func NewProposalValidatorTally(
+	proposalID uint64, validatorAddress string, option string,
+	totalPower, overriddenPower, effectivePower sdk.Int, height int64,
+) ProposalValidatorTally {
+	return ProposalValidatorTally{
+		ProposalID:       proposalID,
+		ValidatorAddress: validatorAddress,
+		Option:           option,
+		TotalPower:       totalPower,
+		OverriddenPower:  overriddenPower,
+		EffectivePower:   effectivePower,
+		Height:           height,
+	}
+}
+
+// ProposalDelegatorTally tells how the stake that a delegator has delegated to a validator has been counted
+// towards a proposal. EffectiveOption is the delegator's own vote if present, or the one inherited from the
+// validator otherwise. Empty options represent missing votes
+type ProposalDelegatorTally struct {
+	ProposalID       uint64
+	DelegatorAddress string
+	ValidatorAddress string
+	Power            sdk.Int
+	DelegatorOption  string
+	ValidatorOption  string
+	EffectiveOption  string
+	Height           int64
+}
+
+// NewProposalDelegatorTally allows to build a new ProposalDelegatorTally instance
+func NewProposalDelegatorTally(
+	proposalID uint64, delegatorAddress, validatorAddress string, power sdk.Int,
+	delegatorOption, validatorOption, effectiveOption string, height int64,
+) ProposalDelegatorTally {
+	return ProposalDelegatorTally{
+		ProposalID:       proposalID,
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: validatorAddress,
+		Power:            power,
+		DelegatorOption:  delegatorOption,
+		ValidatorOption:  validatorOption,
+		EffectiveOption:  effectiveOption,
+		Height:           height,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ProposalTallyProjection contains the outcome that a proposal would have if its voting period ended
+// with the current tally result.
+// Turnout is the ratio between the voting power that has voted and the bonded tokens, YesRatio is the ratio
+// between the yes votes and the non-abstaining ones, while VetoRatio is the ratio between the veto votes and all
+// the votes
+type ProposalTallyProjection struct {
+	ProposalID       uint64
+	Turnout          sdk.Dec
+	YesRatio         sdk.Dec
+	VetoRatio        sdk.Dec
+	QuorumReached    bool
+	ThresholdReached bool
+	Vetoed           bool
+	Outcome          string
+	Height           int64
+}
+
+// NewProposalTallyProjection allows to build a new ProposalTallyProjection instance
+func NewProposalTallyProjection(
+	proposalID uint64, turnout, yesRatio, vetoRatio sdk.Dec,
+	quorumReached, thresholdReached, vetoed bool, outcome string, height int64,
+) ProposalTallyProjection {
+	return ProposalTallyProjection{
+		ProposalID:       proposalID,
+		Turnout:          turnout,
+		YesRatio:         yesRatio,
+		VetoRatio:        vetoRatio,
+		QuorumReached:    quorumReached,
+		ThresholdReached: thresholdReached,
+		Vetoed:           vetoed,
+		Outcome:          outcome,
+		Height:           height,
+	}
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalParamChange represents a single parameter change contained inside a ParameterChangeProposal
+type ProposalParamChange struct {
+	ProposalID uint64
+	Subspace   string
+	Key        string
+	Value      string
+}
+
+// NewProposalParamChange allows to build a new ProposalParamChange instance
+func NewProposalParamChange(proposalID uint64, subspace, key, value string) ProposalParamChange {
+	return ProposalParamChange{
+		ProposalID: proposalID,
+		Subspace:   subspace,
+		Key:        key,
+		Value:      value,
+	}
+}
+
+// ProposalCommunityPoolSpend represents the content of a CommunityPoolSpendProposal
+type ProposalCommunityPoolSpend struct {
+	ProposalID uint64
+	Recipient  string
+	Amount     sdk.Coins
+}
+
+// NewProposalCommunityPoolSpend allows to build a new ProposalCommunityPoolSpend instance
+func NewProposalCommunityPoolSpend(proposalID uint64, recipient string, amount sdk.Coins) ProposalCommunityPoolSpend {
+	return ProposalCommunityPoolSpend{
+		ProposalID: proposalID,
+		Recipient:  recipient,
+		Amount:     amount,
+	}
+}
+
+// ProposalSoftwareUpgrade represents the plan contained inside a SoftwareUpgradeProposal
+type ProposalSoftwareUpgrade struct {
+	ProposalID    uint64
+	PlanName      string
+	UpgradeHeight int64
+	Info          string
+}
+
+// NewProposalSoftwareUpgrade allows to build a new ProposalSoftwareUpgrade instance
+func NewProposalSoftwareUpgrade(proposalID uint64, planName string, upgradeHeight int64, info string) ProposalSoftwareUpgrade {
+	return ProposalSoftwareUpgrade{
+		ProposalID:    proposalID,
+		PlanName:      planName,
+		UpgradeHeight: upgradeHeight,
+		Info:          info,
+	}
+}
+
+// ProposalClientUpdate represents the content of an IBC ClientUpdateProposal.
+// Header contains the JSON representation of the header used to update the client
+type ProposalClientUpdate struct {
+	ProposalID uint64
+	ClientID   string
+	Header     json.RawMessage
+}
+
+// NewProposalClientUpdate allows to build a new ProposalClientUpdate instance
+func NewProposalClientUpdate(proposalID uint64, clientID string, header json.RawMessage) ProposalClientUpdate {
+	return ProposalClientUpdate{
+		ProposalID: proposalID,
+		ClientID:   clientID,
+		Header:     header,
+	}
+}
@@ -0,0 +1,176 @@
+package types
+
+import "time"
+
+const (
+	IBCTransferDirectionOutgoing = "outgoing"
+	IBCTransferDirectionIncoming = "incoming"
+
+	IBCTransferStatusPending      = "pending"
+	IBCTransferStatusReceived     = "received"
+	IBCTransferStatusAcknowledged = "acknowledged"
+	IBCTransferStatusError        = "error"
+	IBCTransferStatusTimeout      = "timeout"
+)
+
+// IBCTransfer represents an ICS-20 transfer that has been sent or received by the chain.
+// Port and Channel always refer to the local chain side of the transfer
+type IBCTransfer struct {
+	Direction           string
+	Port                string
+	Channel             string
+	CounterpartyPort    string
+	CounterpartyChannel string
+	Sequence            uint64
+	Sender              string
+	Receiver            string
+	Denom               string
+	Amount              string
+	Status              string
+	Error               string
+	Height              int64
+}
+
+// NewIBCTransfer allows to build a new IBCTransfer instance
+func NewIBCTransfer(
+	direction, port, channel, counterpartyPort, counterpartyChannel string, sequence uint64,
+	sender, receiver, denom, amount, status, error string, height int64,
+) IBCTransfer {
+	return IBCTransfer{
+		Direction:           direction,
+		Port:                port,
+		Channel:             channel,
+		CounterpartyPort:    counterpartyPort,
+		CounterpartyChannel: counterpartyChannel,
+		Sequence:            sequence,
+		Sender:              sender,
+		Receiver:            receiver,
+		Denom:               denom,
+		Amount:              amount,
+		Status:              status,
+		Error:               error,
+		Height:              height,
+	}
+}
+
+// IBCDenomTrace contains the trace of an IBC denom
+type IBCDenomTrace struct {
+	Denom     string
+	Path      string
+	BaseDenom string
+}
+
+// NewIBCDenomTrace allows to build a new IBCDenomTrace instance
+func NewIBCDenomTrace(denom, path, baseDenom string) IBCDenomTrace {
+	return IBCDenomTrace{
+		Denom:     denom,
+		Path:      path,
+		BaseDenom: baseDenom,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// IBCClient represents an IBC light client that tracks a counterparty chain
+type IBCClient struct {
+	ClientID            string
+	ClientType          string
+	CounterpartyChainID string
+	TrustingPeriod      time.Duration
+	LatestHeight        uint64
+	LatestTimestamp     time.Time
+	Frozen              bool
+	Height              int64
+}
+
+// NewIBCClient allows to build a new IBCClient instance
+func NewIBCClient(
+	clientID, clientType, counterpartyChainID string, trustingPeriod time.Duration,
+	latestHeight uint64, latestTimestamp time.Time, frozen bool, height int64,
+) IBCClient {
+	return IBCClient{
+		ClientID:            clientID,
+		ClientType:          clientType,
+		CounterpartyChainID: counterpartyChainID,
+		TrustingPeriod:      trustingPeriod,
+		LatestHeight:        latestHeight,
+		LatestTimestamp:     latestTimestamp,
+		Frozen:              frozen,
+		Height:              height,
+	}
+}
+
+// ExpiryTime returns the time at which the client will expire if it is not updated before
+func (c IBCClient) ExpiryTime() time.Time {
+	return c.LatestTimestamp.Add(c.TrustingPeriod)
+}
+
+// IBCClientUpdate represents an update of an IBC light client to a new counterparty chain header
+type IBCClientUpdate struct {
+	ClientID        string
+	LatestHeight    uint64
+	LatestTimestamp time.Time
+	Height          int64
+}
+
+// NewIBCClientUpdate allows to build a new IBCClientUpdate instance
+func NewIBCClientUpdate(clientID string, latestHeight uint64, latestTimestamp time.Time, height int64) IBCClientUpdate {
+	return IBCClientUpdate{
+		ClientID:        clientID,
+		LatestHeight:    latestHeight,
+		LatestTimestamp: latestTimestamp,
+		Height:          height,
+	}
+}
+
+// IBCConnection represents an IBC connection built on top of a light client.
+// Counterparty identifiers might be empty while the handshake is not completed
+type IBCConnection struct {
+	ConnectionID             string
+	ClientID                 string
+	State                    string
+	CounterpartyClientID     string
+	CounterpartyConnectionID string
+	Height                   int64
+}
+
+// NewIBCConnection allows to build a new IBCConnection instance
+func NewIBCConnection(
+	connectionID, clientID, state, counterpartyClientID, counterpartyConnectionID string, height int64,
+) IBCConnection {
+	return IBCConnection{
+		ConnectionID:             connectionID,
+		ClientID:                 clientID,
+		State:                    state,
+		CounterpartyClientID:     counterpartyClientID,
+		CounterpartyConnectionID: counterpartyConnectionID,
+		Height:                   height,
+	}
+}
+
+// IBCChannel represents an IBC channel built on top of a connection.
+// The counterparty channel might be empty while the handshake is not completed
+type IBCChannel struct {
+	Port                string
+	Channel             string
+	State               string
+	ConnectionID        string
+	CounterpartyPort    string
+	CounterpartyChannel string
+	Height              int64
+}
+
+// NewIBCChannel allows to build a new IBCChannel instance
+func NewIBCChannel(
+	port, channel, state, connectionID, counterpartyPort, counterpartyChannel string, height int64,
+) IBCChannel {
+	return IBCChannel{
+		Port:                port,
+		Channel:             channel,
+		State:               state,
+		ConnectionID:        connectionID,
+		CounterpartyPort:    counterpartyPort,
+		CounterpartyChannel: counterpartyChannel,
+		Height:              height,
+	}
+}
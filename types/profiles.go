@@ -0,0 +1,89 @@
+package types
+
+import (
+	"time"
+
+	profilestypes "github.com/desmos-labs/desmos/x/profiles/types"
+)
+
+// Profile represents the Desmos profile associated to an account
+type Profile struct {
+	Address      string
+	DTag         string
+	Nickname     string
+	Bio          string
+	ProfilePic   string
+	CoverPic     string
+	CreationTime time.Time
+	Height       int64
+}
+
+// NewProfile allows to build a new Profile instance from the given Desmos profile
+func NewProfile(profile *profilestypes.Profile, height int64) Profile {
+	return Profile{
+		Address:      profile.GetAddress().String(),
+		DTag:         profile.DTag,
+		Nickname:     profile.Nickname,
+		Bio:          profile.Bio,
+		ProfilePic:   profile.Pictures.Profile,
+		CoverPic:     profile.Pictures.Cover,
+		CreationTime: profile.CreationDate,
+		Height:       height,
+	}
+}
+
+// DTagTransferRequest represents a pending request made by the sender to get the DTag of the receiver
+type DTagTransferRequest struct {
+	DTagToTrade string
+	Sender      string
+	Receiver    string
+	Height      int64
+}
+
+// NewDTagTransferRequest allows to build a new DTagTransferRequest instance
+func NewDTagTransferRequest(request profilestypes.DTagTransferRequest, height int64) DTagTransferRequest {
+	return DTagTransferRequest{
+		DTagToTrade: request.DTagToTrade,
+		Sender:      request.Sender,
+		Receiver:    request.Receiver,
+		Height:      height,
+	}
+}
+
+// Relationship represents a relationship created by the creator towards the recipient inside a subspace
+type Relationship struct {
+	Creator   string
+	Recipient string
+	Subspace  string
+	Height    int64
+}
+
+// NewRelationship allows to build a new Relationship instance
+func NewRelationship(relationship profilestypes.Relationship, height int64) Relationship {
+	return Relationship{
+		Creator:   relationship.Creator,
+		Recipient: relationship.Recipient,
+		Subspace:  relationship.Subspace,
+		Height:    height,
+	}
+}
+
+// UserBlock represents the block of the blocked user made by the blocker inside a subspace
+type UserBlock struct {
+	Blocker  string
+	Blocked  string
+	Reason   string
+	Subspace string
+	Height   int64
+}
+
+// NewUserBlock allows to build a new UserBlock instance
+func NewUserBlock(block profilestypes.UserBlock, height int64) UserBlock {
+	return UserBlock{
+		Blocker:  block.Blocker,
+		Blocked:  block.Blocked,
+		Reason:   block.Reason,
+		Subspace: block.Subspace,
+		Height:   height,
+	}
+}
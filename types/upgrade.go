@@ -0,0 +1,43 @@
+package types
+
+import upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+// UpgradePlan represents the software upgrade that is currently planned.
+// ProposalID is zero when the proposal that has scheduled the upgrade is not known
+type UpgradePlan struct {
+	Name          string
+	UpgradeHeight int64
+	Info          string
+	ProposalID    uint64
+	Height        int64
+}
+
+// NewUpgradePlan allows to build a new UpgradePlan instance
+func NewUpgradePlan(plan upgradetypes.Plan, proposalID uint64, height int64) UpgradePlan {
+	return UpgradePlan{
+		Name:          plan.Name,
+		UpgradeHeight: plan.Height,
+		Info:          plan.Info,
+		ProposalID:    proposalID,
+		Height:        height,
+	}
+}
+
+// AppliedUpgrade represents a software upgrade that has been applied.
+// Height is the height of the block at which the chain halted and the upgrade took effect
+type AppliedUpgrade struct {
+	Name       string
+	Info       string
+	ProposalID uint64
+	Height     int64
+}
+
+// NewAppliedUpgrade allows to build a new AppliedUpgrade instance
+func NewAppliedUpgrade(name, info string, proposalID uint64, height int64) AppliedUpgrade {
+	return AppliedUpgrade{
+		Name:       name,
+		Info:       info,
+		ProposalID: proposalID,
+		Height:     height,
+	}
+}